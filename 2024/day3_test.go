@@ -1,23 +0,0 @@
-package main
-
-import (
-	"testing"
-)
-
-func TestDay3Part1(t *testing.T) {
-	filename := "input/day3.test"
-	want := 161
-	got := Day3Part1(filename)
-	if got != want {
-		t.Errorf("Day3Part1(%q) got %d; want: %d", filename, got, want)
-	}
-}
-
-func TestDay3Part2(t *testing.T) {
-	filename := "input/day3p2.test"
-	want := 48
-	got := Day3Part2(filename)
-	if got != want {
-		t.Errorf("Day3Part2(%q) got %d; want: %d", filename, got, want)
-	}
-}
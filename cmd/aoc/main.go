@@ -0,0 +1,172 @@
+// Command aoc runs the solutions of every registered puzzle with its input
+//
+// It has to run from the root of the repository, or be given its path with
+// -root, to find the inputs. Pressing Ctrl-C cancels the parts that are still
+// running, pressing it again quits right away
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"maps"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/unkiwii/aoc/lib/render"
+	"github.com/unkiwii/aoc/lib/runner"
+	"github.com/unkiwii/aoc/lib/trace"
+
+	_ "github.com/unkiwii/aoc/years/y2024"
+	_ "github.com/unkiwii/aoc/years/y2025"
+)
+
+func main() {
+	year := flag.Int("year", 0, "run only the puzzles of this year")
+	day := flag.Int("day", 0, "run only the puzzles of this day")
+	part := flag.Int("part", 0, "run only this part of the puzzles")
+	root := flag.String("root", ".", "path of the root of the repository")
+	workers := flag.Int("workers", 0, "amount of parts solved at the same time, defaults to one per CPU")
+	timeout := flag.Duration("timeout", 0, "time each part has to be solved, like 10s, defaults to no timeout")
+	progress := flag.Bool("progress", isTerminal(os.Stderr), "show the progress of the running parts on stderr")
+	var profiles runner.Profiles
+	flag.StringVar(&profiles.CPU, "cpuprofile", "", "write the CPU profile of a single part to this file")
+	flag.StringVar(&profiles.Mem, "memprofile", "", "write the memory profile of a single part to this file")
+	flag.StringVar(&profiles.Trace, "trace", "", "write the execution trace of a single part to this file")
+	profileAll := flag.String("profile-all", "", "write every profile of each part, one after the other, to this dir")
+	viz := flag.Bool("viz", false, "animate the grid of a single part on the terminal, if its solver draws it")
+	vizFPS := flag.Int("viz-fps", 10, "frames per second of the animation")
+	vizWidth := flag.Int("viz-width", 80, "columns of the grid shown, the view follows the changes")
+	vizHeight := flag.Int("viz-height", 40, "rows of the grid shown, the view follows the changes")
+	vizX := flag.Int("viz-x", 0, "first column shown, setting it or -viz-y stops following the changes")
+	vizY := flag.Int("viz-y", 0, "first row shown, setting it or -viz-x stops following the changes")
+	renderFile := flag.String("render", "", "write a drawing of a single part to this file instead of solving it, an .svg or a .gif depending on the part")
+	logFormat := flag.String("log", "", "log the steps of a single part to stderr as text or json lines, if its solver traces them")
+	flag.Parse()
+
+	parts := runner.Parts(*year, *day, *part)
+	if len(parts) == 0 {
+		log.Fatalf("there are no puzzles for year %d, day %d and part %d", *year, *day, *part)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	go func() {
+		// after the first Ctrl-C the next one kills the program
+		<-ctx.Done()
+		stop()
+	}()
+
+	opts := runner.Options{
+		Workers: *workers,
+		Timeout: *timeout,
+		Input: func(p runner.Part) string {
+			return p.Input(filepath.Join(runner.YearDir(*root, p.Year), "input"))
+		},
+	}
+
+	if *renderFile != "" {
+		if len(parts) != 1 {
+			log.Fatalf("can't draw %d parts at once, choose one with -year, -day and -part", len(parts))
+		}
+		p := parts[0]
+		ext := filepath.Ext(*renderFile)
+		draw, ok := runner.Renderers(p)[ext]
+		if !ok {
+			log.Fatalf("%s has no %q drawing, it has: %v", p.Name(), ext, slices.Sorted(maps.Keys(runner.Renderers(p))))
+		}
+
+		f, err := os.Create(*renderFile)
+		if err != nil {
+			log.Fatalf("can't create file %q: %v", *renderFile, err)
+		}
+		if err := draw(opts.Input(p), f); err != nil {
+			f.Close()
+			log.Fatalf("can't draw %s: %v", p.Name(), err)
+		}
+		if err := f.Close(); err != nil {
+			log.Fatalf("can't write file %q: %v", *renderFile, err)
+		}
+		return
+	}
+
+	switch {
+	case *profileAll != "":
+		if err := os.MkdirAll(*profileAll, 0o755); err != nil {
+			log.Fatalf("can't create dir %q: %v", *profileAll, err)
+		}
+		opts.Profiles = func(p runner.Part) runner.Profiles {
+			return runner.ProfilesIn(*profileAll, p)
+		}
+	case profiles != runner.Profiles{}:
+		if len(parts) != 1 {
+			log.Fatalf("can't profile %d parts at once, choose one with -year, -day and -part or use -profile-all", len(parts))
+		}
+		opts.Profiles = func(runner.Part) runner.Profiles { return profiles }
+	}
+
+	if *viz {
+		if len(parts) != 1 {
+			log.Fatalf("can't animate %d parts at once, choose one with -year, -day and -part", len(parts))
+		}
+		term := render.NewTerminal(os.Stdout, *vizFPS, *vizWidth, *vizHeight)
+		pinned := false
+		flag.Visit(func(f *flag.Flag) {
+			pinned = pinned || f.Name == "viz-x" || f.Name == "viz-y"
+		})
+		if pinned {
+			term.Scroll(*vizX, *vizY)
+		}
+		defer term.Close()
+		ctx = render.NewContext(ctx, term)
+		*progress = false
+	}
+
+	if *logFormat != "" {
+		if len(parts) != 1 {
+			log.Fatalf("can't log the steps of %d parts at once, choose one with -year, -day and -part", len(parts))
+		}
+		l, err := trace.New(os.Stderr, *logFormat)
+		if err != nil {
+			log.Fatalf("can't log the steps: %v", err)
+		}
+		ctx = trace.NewContext(ctx, l)
+		*progress = false
+	}
+
+	// the bars are drawn between results, never in the middle of one
+	var mu sync.Mutex
+	var bars *runner.Bars
+	if *progress {
+		bars = runner.NewBars(os.Stderr, 20)
+		opts.Track = bars.Track
+
+		ticker := time.NewTicker(100 * time.Millisecond)
+		defer ticker.Stop()
+		go func() {
+			for range ticker.C {
+				mu.Lock()
+				bars.Draw()
+				mu.Unlock()
+			}
+		}()
+	}
+
+	for result := range runner.Run(ctx, parts, opts) {
+		mu.Lock()
+		if bars != nil {
+			bars.Clear()
+		}
+		fmt.Println(result)
+		mu.Unlock()
+	}
+}
+
+func isTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
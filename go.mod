@@ -1,5 +1,3 @@
 module github.com/unkiwii/aoc
 
 go 1.24.3
-
-require gonum.org/v1/gonum v0.16.0 // indirect
@@ -0,0 +1,278 @@
+package bitset
+
+import (
+	"fmt"
+	"hash/fnv"
+	"iter"
+	"math/bits"
+	"strings"
+)
+
+const wordSize = 64
+
+// Bitset is a growable set of bits indexed from 0
+//
+// Setting or toggling a bit past the current length grows the set, reading a
+// bit past the current length returns false
+type Bitset struct {
+	words []uint64
+	size  int
+}
+
+// New returns a bitset of size bits, all of them clear
+func New(size int) *Bitset {
+	return &Bitset{
+		words: make([]uint64, wordsFor(size)),
+		size:  size,
+	}
+}
+
+// Parse a bitset from a "[.##.]" string, where '#' means the bit is set and
+// '.' means the bit is clear. The brackets are optional.
+func Parse(s string) (*Bitset, error) {
+	s, err := trimBrackets(s)
+	if err != nil {
+		return nil, err
+	}
+
+	b := New(len(s))
+	for i := range len(s) {
+		switch s[i] {
+		case '#':
+			b.Set(i)
+		case '.':
+		default:
+			return nil, fmt.Errorf("invalid bit %q at position %d", s[i], i)
+		}
+	}
+	return b, nil
+}
+
+// Len returns the number of bits, set or not
+func (b *Bitset) Len() int {
+	return b.size
+}
+
+// Test reports whether the i-th bit is set
+func (b *Bitset) Test(i int) bool {
+	if i < 0 || i >= b.size {
+		return false
+	}
+	return b.words[i/wordSize]&(1<<(i%wordSize)) != 0
+}
+
+// Set sets the i-th bit, growing b if i is past its length
+func (b *Bitset) Set(i int) *Bitset {
+	b.grow(i + 1)
+	b.words[i/wordSize] |= 1 << (i % wordSize)
+	return b
+}
+
+// Clear clears the i-th bit, bits past the length are already clear
+func (b *Bitset) Clear(i int) *Bitset {
+	if i >= 0 && i < b.size {
+		b.words[i/wordSize] &^= 1 << (i % wordSize)
+	}
+	return b
+}
+
+// Toggle flips the i-th bit, growing b if i is past its length
+func (b *Bitset) Toggle(i int) *Bitset {
+	b.grow(i + 1)
+	b.words[i/wordSize] ^= 1 << (i % wordSize)
+	return b
+}
+
+// Reset clears every bit without changing the length
+func (b *Bitset) Reset() *Bitset {
+	clear(b.words)
+	return b
+}
+
+// Xor sets b to b ^ o, growing b if o is longer
+func (b *Bitset) Xor(o *Bitset) *Bitset {
+	b.grow(o.size)
+	for i, w := range o.words {
+		b.words[i] ^= w
+	}
+	return b
+}
+
+// Or sets b to b | o, growing b if o is longer
+func (b *Bitset) Or(o *Bitset) *Bitset {
+	b.grow(o.size)
+	for i, w := range o.words {
+		b.words[i] |= w
+	}
+	return b
+}
+
+// And sets b to b & o, growing b if o is longer
+func (b *Bitset) And(o *Bitset) *Bitset {
+	b.grow(o.size)
+	for i := range b.words {
+		if i < len(o.words) {
+			b.words[i] &= o.words[i]
+		} else {
+			b.words[i] = 0
+		}
+	}
+	return b
+}
+
+// AndNot sets b to b &^ o
+func (b *Bitset) AndNot(o *Bitset) *Bitset {
+	for i := range min(len(b.words), len(o.words)) {
+		b.words[i] &^= o.words[i]
+	}
+	return b
+}
+
+// Count returns the number of bits set
+func (b *Bitset) Count() int {
+	count := 0
+	for _, w := range b.words {
+		count += bits.OnesCount64(w)
+	}
+	return count
+}
+
+// Any reports whether at least one bit is set
+func (b *Bitset) Any() bool {
+	for _, w := range b.words {
+		if w != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// None reports whether no bit is set
+func (b *Bitset) None() bool {
+	return !b.Any()
+}
+
+// Equal reports whether both bitsets have the same length and bits set
+func (b *Bitset) Equal(o *Bitset) bool {
+	return b.size == o.size && b.SameBits(o)
+}
+
+// SameBits reports whether both bitsets have the same bits set, regardless of
+// their length
+func (b *Bitset) SameBits(o *Bitset) bool {
+	short, long := b.words, o.words
+	if len(short) > len(long) {
+		short, long = long, short
+	}
+	for i, w := range long {
+		if i < len(short) {
+			if short[i] != w {
+				return false
+			}
+		} else if w != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a copy of b that can be changed without changing b
+func (b *Bitset) Clone() *Bitset {
+	c := &Bitset{
+		words: make([]uint64, len(b.words)),
+		size:  b.size,
+	}
+	copy(c.words, b.words)
+	return c
+}
+
+// All iterates over the index of every bit set, in increasing order
+func (b *Bitset) All() iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for i, w := range b.words {
+			for w != 0 {
+				if !yield(i*wordSize + bits.TrailingZeros64(w)) {
+					return
+				}
+				w &= w - 1
+			}
+		}
+	}
+}
+
+// Hash returns a hash of the bits set, regardless of the length, so two
+// bitsets with the same bits have the same hash
+func (b *Bitset) Hash() uint64 {
+	h := fnv.New64a()
+	var buf [8]byte
+	for _, w := range b.trimmed() {
+		for i := range buf {
+			buf[i] = byte(w >> (8 * i))
+		}
+		h.Write(buf[:])
+	}
+	return h.Sum64()
+}
+
+// Key returns a string that can be used as a map key, regardless of the
+// length, so two bitsets with the same bits have the same key
+func (b *Bitset) Key() string {
+	words := b.trimmed()
+	buf := make([]byte, 0, len(words)*8)
+	for _, w := range words {
+		for i := range 8 {
+			buf = append(buf, byte(w>>(8*i)))
+		}
+	}
+	return string(buf)
+}
+
+// String returns the bits like "[.##.]", the format Parse reads
+func (b *Bitset) String() string {
+	var buf strings.Builder
+	buf.WriteByte('[')
+	for i := range b.size {
+		if b.Test(i) {
+			buf.WriteByte('#')
+		} else {
+			buf.WriteByte('.')
+		}
+	}
+	buf.WriteByte(']')
+	return buf.String()
+}
+
+func (b *Bitset) grow(size int) {
+	if size <= b.size {
+		return
+	}
+	if n := wordsFor(size); n > len(b.words) {
+		b.words = append(b.words, make([]uint64, n-len(b.words))...)
+	}
+	b.size = size
+}
+
+// trimmed returns the words without the trailing zero words
+func (b *Bitset) trimmed() []uint64 {
+	words := b.words
+	for len(words) > 0 && words[len(words)-1] == 0 {
+		words = words[:len(words)-1]
+	}
+	return words
+}
+
+func wordsFor(size int) int {
+	return (size + wordSize - 1) / wordSize
+}
+
+func trimBrackets(s string) (string, error) {
+	hasOpen := strings.HasPrefix(s, "[")
+	hasClose := strings.HasSuffix(s, "]")
+	if hasOpen != hasClose || (hasOpen && len(s) < 2) {
+		return "", fmt.Errorf("unbalanced brackets in %q", s)
+	}
+	if hasOpen {
+		s = s[1 : len(s)-1]
+	}
+	return s, nil
+}
@@ -0,0 +1,168 @@
+package bitset
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	for _, tc := range []struct {
+		s       string
+		want    []int
+		size    int
+		wantErr bool
+	}{
+		{s: "[]", want: nil, size: 0},
+		{s: "[.##.]", want: []int{1, 2}, size: 4},
+		{s: "[...#.]", want: []int{3}, size: 5},
+		{s: ".###.#", want: []int{1, 2, 3, 5}, size: 6},
+		{s: "[.#x]", wantErr: true},
+		{s: "[.#", wantErr: true},
+		{s: "#.]", wantErr: true},
+	} {
+		t.Run(fmt.Sprintf("Parse(%q)", tc.s), func(t *testing.T) {
+			b, err := Parse(tc.s)
+			f, ferr := ParseFixed(tc.s)
+			if tc.wantErr {
+				if err == nil || ferr == nil {
+					t.Fatalf("want error, got: %v and %v", err, ferr)
+				}
+				return
+			}
+			if err != nil || ferr != nil {
+				t.Fatalf("unexpected error: %v, %v", err, ferr)
+			}
+			if got := slices.Collect(b.All()); !slices.Equal(got, tc.want) {
+				t.Errorf("Bitset want: %v, got: %v", tc.want, got)
+			}
+			if got := slices.Collect(f.All()); !slices.Equal(got, tc.want) {
+				t.Errorf("Fixed want: %v, got: %v", tc.want, got)
+			}
+			if b.Len() != tc.size || f.Len() != tc.size {
+				t.Errorf("want size %d, got: %d and %d", tc.size, b.Len(), f.Len())
+			}
+			if b.String() != f.String() {
+				t.Errorf("String() differs: %s != %s", b, f)
+			}
+		})
+	}
+}
+
+func TestBitsetGrow(t *testing.T) {
+	b := New(3)
+	b.Set(1).Set(130).Toggle(64).Toggle(1)
+
+	if got, want := b.Len(), 131; got != want {
+		t.Errorf("Len() got %d; want: %d", got, want)
+	}
+	if got, want := slices.Collect(b.All()), []int{64, 130}; !slices.Equal(got, want) {
+		t.Errorf("All() got %v; want: %v", got, want)
+	}
+	if b.Test(1000) {
+		t.Errorf("Test(1000) got true; want: false")
+	}
+
+	b.Clear(130).Clear(1000)
+	if got, want := b.Count(), 1; got != want {
+		t.Errorf("Count() got %d; want: %d", got, want)
+	}
+}
+
+func TestSetAlgebra(t *testing.T) {
+	a, _ := Parse("[##..#...]")
+	b, _ := Parse("[.#.#]")
+
+	for _, tc := range []struct {
+		name string
+		got  *Bitset
+		want string
+	}{
+		{name: "xor", got: a.Clone().Xor(b), want: "[#..##...]"},
+		{name: "or", got: a.Clone().Or(b), want: "[##.##...]"},
+		{name: "and", got: a.Clone().And(b), want: "[.#......]"},
+		{name: "andnot", got: a.Clone().AndNot(b), want: "[#...#...]"},
+		{name: "and grows", got: b.Clone().And(a), want: "[.#......]"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.got.String(); got != tc.want {
+				t.Errorf("want: %s, got: %s", tc.want, got)
+			}
+		})
+	}
+
+	fa, _ := ParseFixed("[##..#...]")
+	fb, _ := ParseFixed("[.#.#]")
+	if got, want := fa.Xor(fb).String(), "[#..##...]"; got != want {
+		t.Errorf("Fixed xor want: %s, got: %s", want, got)
+	}
+	if got, want := fa.And(fb).Count(), 1; got != want {
+		t.Errorf("Fixed and count want: %d, got: %d", want, got)
+	}
+}
+
+func TestEqualAndHash(t *testing.T) {
+	a, _ := Parse("[.#..]")
+	b := New(200).Set(1)
+
+	if !a.SameBits(b) || !b.SameBits(a) {
+		t.Errorf("%s and %s should have the same bits", a, b)
+	}
+	if a.Equal(b) || b.Equal(a) {
+		t.Errorf("%s and %s should not be equal, their lengths differ", a, b)
+	}
+	if c := New(4).Set(1); !a.Equal(c) || !c.Equal(a) {
+		t.Errorf("%s and %s should be equal", a, c)
+	}
+	if a.Hash() != b.Hash() {
+		t.Errorf("bitsets with the same bits have different hashes")
+	}
+	if a.Key() != b.Key() {
+		t.Errorf("bitsets with the same bits have different keys")
+	}
+
+	b.Set(150)
+	if a.SameBits(b) {
+		t.Errorf("%s and %s should not have the same bits", a, b)
+	}
+	if a.Key() == b.Key() {
+		t.Errorf("different bitsets have the same key")
+	}
+
+	seen := map[Fixed]bool{}
+	f, _ := ParseFixed("[.##.]")
+	seen[f] = true
+	g := NewFixed(4).Set(2).Set(1)
+	if !seen[g] {
+		t.Errorf("Fixed %s not found as map key", g)
+	}
+	if f.Hash() != g.Hash() {
+		t.Errorf("equal fixed bitsets have different hashes")
+	}
+	if !f.Equal(g) {
+		t.Errorf("%s and %s should be equal", f, g)
+	}
+
+	// like == the size counts, even if the bits set are the same
+	h := NewFixed(8).Set(2).Set(1)
+	if f.Equal(h) || f == h {
+		t.Errorf("%s and %s should not be equal", f, h)
+	}
+	if !f.SameBits(h) {
+		t.Errorf("%s and %s should have the same bits", f, h)
+	}
+}
+
+func TestAllStopsEarly(t *testing.T) {
+	b, _ := Parse("[#######]")
+	var got []int
+	for i := range b.All() {
+		got = append(got, i)
+		if len(got) == 3 {
+			break
+		}
+	}
+	if want := []int{0, 1, 2}; !slices.Equal(got, want) {
+		t.Errorf("want: %v, got: %v", want, got)
+	}
+}
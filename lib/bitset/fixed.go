@@ -0,0 +1,212 @@
+package bitset
+
+import (
+	"fmt"
+	"iter"
+	"math/bits"
+	"strings"
+)
+
+// MaxFixedSize is the maximum amount of bits a Fixed bitset can hold
+const MaxFixedSize = 64
+
+// Fixed is a bitset of up to 64 bits stored by value
+//
+// Every operation returns a new Fixed instead of modifying the receiver, and
+// Fixed is comparable, so it can be used directly as a map key or as a state
+// in a breadth-first search
+type Fixed struct {
+	bits uint64
+	size int
+}
+
+// NewFixed returns a Fixed of size bits, all of them clear, it panics if size
+// is negative or more than MaxFixedSize
+func NewFixed(size int) Fixed {
+	if size < 0 || size > MaxFixedSize {
+		panic(fmt.Sprintf("bitset: invalid fixed size %d", size))
+	}
+	return Fixed{size: size}
+}
+
+// FixedFromIndices returns a Fixed of the given size with the bits at the
+// given indices set
+func FixedFromIndices(size int, indices ...int) (Fixed, error) {
+	f := NewFixed(size)
+	for _, i := range indices {
+		if i < 0 || i >= size {
+			return Fixed{}, fmt.Errorf("index %d out of range [0, %d)", i, size)
+		}
+		f = f.Set(i)
+	}
+	return f, nil
+}
+
+// ParseFixed parses a bitset from a "[.##.]" string, where '#' means the bit
+// is set and '.' means the bit is clear. The brackets are optional.
+func ParseFixed(s string) (Fixed, error) {
+	s, err := trimBrackets(s)
+	if err != nil {
+		return Fixed{}, err
+	}
+	if len(s) > MaxFixedSize {
+		return Fixed{}, fmt.Errorf("can't fit %d bits in a fixed bitset of %d bits", len(s), MaxFixedSize)
+	}
+
+	f := NewFixed(len(s))
+	for i := range len(s) {
+		switch s[i] {
+		case '#':
+			f = f.Set(i)
+		case '.':
+		default:
+			return Fixed{}, fmt.Errorf("invalid bit %q at position %d", s[i], i)
+		}
+	}
+	return f, nil
+}
+
+// Len returns the number of bits, set or not
+func (f Fixed) Len() int {
+	return f.size
+}
+
+// Uint64 returns the bits of f, the i-th bit is 1 << i
+func (f Fixed) Uint64() uint64 {
+	return f.bits
+}
+
+// Test reports whether the i-th bit is set
+func (f Fixed) Test(i int) bool {
+	if i < 0 || i >= f.size {
+		return false
+	}
+	return f.bits&(1<<i) != 0
+}
+
+// Set returns f with the i-th bit set, it panics if i is out of range
+func (f Fixed) Set(i int) Fixed {
+	f.bits |= f.bit(i)
+	return f
+}
+
+// Clear returns f with the i-th bit clear, it panics if i is out of range
+func (f Fixed) Clear(i int) Fixed {
+	f.bits &^= f.bit(i)
+	return f
+}
+
+// Toggle returns f with the i-th bit flipped, it panics if i is out of range
+func (f Fixed) Toggle(i int) Fixed {
+	f.bits ^= f.bit(i)
+	return f
+}
+
+// Xor returns f ^ o, as long as the longest of both
+func (f Fixed) Xor(o Fixed) Fixed {
+	f.bits ^= o.bits
+	f.size = max(f.size, o.size)
+	return f
+}
+
+// Or returns f | o, as long as the longest of both
+func (f Fixed) Or(o Fixed) Fixed {
+	f.bits |= o.bits
+	f.size = max(f.size, o.size)
+	return f
+}
+
+// And returns f & o, as long as the longest of both
+func (f Fixed) And(o Fixed) Fixed {
+	f.bits &= o.bits
+	f.size = max(f.size, o.size)
+	return f
+}
+
+// AndNot returns f &^ o, as long as f
+func (f Fixed) AndNot(o Fixed) Fixed {
+	f.bits &^= o.bits
+	return f
+}
+
+// Count returns the number of bits set
+func (f Fixed) Count() int {
+	return bits.OnesCount64(f.bits)
+}
+
+// Any reports whether at least one bit is set
+func (f Fixed) Any() bool {
+	return f.bits != 0
+}
+
+// None reports whether no bit is set
+func (f Fixed) None() bool {
+	return f.bits == 0
+}
+
+// Equal reports whether both bitsets have the same length and bits set, like
+// == does
+func (f Fixed) Equal(o Fixed) bool {
+	return f == o
+}
+
+// SameBits reports whether both bitsets have the same bits set, regardless of
+// their length
+func (f Fixed) SameBits(o Fixed) bool {
+	return f.bits == o.bits
+}
+
+// All iterates over the index of every bit set, in increasing order
+func (f Fixed) All() iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for w := f.bits; w != 0; w &= w - 1 {
+			if !yield(bits.TrailingZeros64(w)) {
+				return
+			}
+		}
+	}
+}
+
+// Hash returns a well mixed hash of the bits set, two equal bitsets have the
+// same hash
+func (f Fixed) Hash() uint64 {
+	// splitmix64 finalizer
+	h := f.bits
+	h ^= h >> 30
+	h *= 0xbf58476d1ce4e5b9
+	h ^= h >> 27
+	h *= 0x94d049bb133111eb
+	h ^= h >> 31
+	return h
+}
+
+// Bitset returns a growable copy of f
+func (f Fixed) Bitset() *Bitset {
+	b := New(f.size)
+	if len(b.words) > 0 {
+		b.words[0] = f.bits
+	}
+	return b
+}
+
+// String returns the bits like "[.##.]", the format ParseFixed reads
+func (f Fixed) String() string {
+	var buf strings.Builder
+	buf.WriteByte('[')
+	for i := range f.size {
+		if f.Test(i) {
+			buf.WriteByte('#')
+		} else {
+			buf.WriteByte('.')
+		}
+	}
+	buf.WriteByte(']')
+	return buf.String()
+}
+
+func (f Fixed) bit(i int) uint64 {
+	if i < 0 || i >= f.size {
+		panic(fmt.Sprintf("bitset: index %d out of range [0, %d)", i, f.size))
+	}
+	return 1 << i
+}
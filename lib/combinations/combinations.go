@@ -1,21 +1,196 @@
+// Package combinations enumerates combinations, permutations and products of
+// slices lazily
+//
+// Every sequence in this package yields the same slice on each iteration,
+// overwriting its contents, so no allocation happens after the first one. If
+// the values must outlive the iteration the caller has to copy them (for
+// example with slices.Clone)
 package combinations
 
-import (
-	"gonum.org/v1/gonum/stat/combin"
-)
+import "iter"
 
+// Choose returns every combination of n elements from the given slice
+//
+// Unlike Combinations, every combination returned is a new slice
 func Choose[T any](n int, from []T) [][]T {
-	indices := combin.Combinations(len(from), n)
-	return mapIndices(from, indices)
+	var result [][]T
+	for c := range Combinations(n, from) {
+		result = append(result, append([]T(nil), c...))
+	}
+	return result
 }
 
-func mapIndices[T any](from []T, indices [][]int) [][]T {
-	result := make([][]T, len(indices))
-	for i, list := range indices {
-		result[i] = make([]T, len(list))
-		for j, index := range list {
-			result[i][j] = from[index]
+// Combinations yields every combination of k elements from the given slice,
+// without repetition and in lexicographic order of their indices
+//
+// For example Combinations(2, []int{1, 2, 3}) yields [1 2], [1 3] and [2 3]
+func Combinations[T any](k int, from []T) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		n := len(from)
+		if k < 0 || k > n {
+			return
+		}
+
+		indices := make([]int, k)
+		for i := range indices {
+			indices[i] = i
+		}
+		buf := make([]T, k)
+
+		for {
+			for i, index := range indices {
+				buf[i] = from[index]
+			}
+			if !yield(buf) {
+				return
+			}
+
+			// find the rightmost index that can still be moved forward
+			i := k - 1
+			for i >= 0 && indices[i] == n-k+i {
+				i--
+			}
+			if i < 0 {
+				return
+			}
+			indices[i]++
+			for j := i + 1; j < k; j++ {
+				indices[j] = indices[j-1] + 1
+			}
+		}
+	}
+}
+
+// CombinationsWithReplacement yields every combination of k elements from the
+// given slice allowing each element to be picked more than once, in
+// lexicographic order of their indices
+//
+// For example CombinationsWithReplacement(2, []int{1, 2}) yields [1 1], [1 2]
+// and [2 2]
+func CombinationsWithReplacement[T any](k int, from []T) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		n := len(from)
+		if k < 0 || (n == 0 && k > 0) {
+			return
+		}
+
+		indices := make([]int, k)
+		buf := make([]T, k)
+
+		for {
+			for i, index := range indices {
+				buf[i] = from[index]
+			}
+			if !yield(buf) {
+				return
+			}
+
+			i := k - 1
+			for i >= 0 && indices[i] == n-1 {
+				i--
+			}
+			if i < 0 {
+				return
+			}
+			indices[i]++
+			for j := i + 1; j < k; j++ {
+				indices[j] = indices[i]
+			}
+		}
+	}
+}
+
+// Permutations yields every ordered arrangement of k distinct elements from
+// the given slice, in lexicographic order of their indices
+//
+// For example Permutations(2, []int{1, 2, 3}) yields [1 2], [1 3], [2 1],
+// [2 3], [3 1] and [3 2]
+func Permutations[T any](k int, from []T) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		n := len(from)
+		if k < 0 || k > n {
+			return
+		}
+
+		indices := make([]int, k)
+		used := make([]bool, n)
+		buf := make([]T, k)
+
+		var permute func(depth int) bool
+		permute = func(depth int) bool {
+			if depth == k {
+				for i, index := range indices {
+					buf[i] = from[index]
+				}
+				return yield(buf)
+			}
+			for i := range n {
+				if used[i] {
+					continue
+				}
+				used[i] = true
+				indices[depth] = i
+				ok := permute(depth + 1)
+				used[i] = false
+				if !ok {
+					return false
+				}
+			}
+			return true
+		}
+
+		permute(0)
+	}
+}
+
+// CartesianProduct yields every slice made by picking one element from each
+// of the given sets, varying the last set the fastest
+//
+// For example CartesianProduct([]int{1, 2}, []int{3, 4}) yields [1 3], [1 4],
+// [2 3] and [2 4]
+func CartesianProduct[T any](sets ...[]T) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		for _, set := range sets {
+			if len(set) == 0 {
+				return
+			}
+		}
+
+		indices := make([]int, len(sets))
+		buf := make([]T, len(sets))
+
+		for {
+			for i, index := range indices {
+				buf[i] = sets[i][index]
+			}
+			if !yield(buf) {
+				return
+			}
+
+			i := len(sets) - 1
+			for i >= 0 && indices[i] == len(sets[i])-1 {
+				indices[i] = 0
+				i--
+			}
+			if i < 0 {
+				return
+			}
+			indices[i]++
+		}
+	}
+}
+
+// PowerSet yields every subset of the given slice, from the smallest (the
+// empty set) to the biggest (the whole slice), so the first subset that
+// satisfies a condition is also the smallest one
+func PowerSet[T any](from []T) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		for k := 0; k <= len(from); k++ {
+			for c := range Combinations(k, from) {
+				if !yield(c) {
+					return
+				}
+			}
 		}
 	}
-	return result
 }
@@ -0,0 +1,126 @@
+package combinations
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+	"testing"
+)
+
+func collect[T any](seq iter.Seq[[]T]) [][]T {
+	var result [][]T
+	for s := range seq {
+		result = append(result, slices.Clone(s))
+	}
+	return result
+}
+
+func equal[T comparable](a, b [][]T) bool {
+	return slices.EqualFunc(a, b, func(x, y []T) bool { return slices.Equal(x, y) })
+}
+
+func TestCombinations(t *testing.T) {
+	from := []int{1, 2, 3, 4}
+	for _, tc := range []struct {
+		k    int
+		want [][]int
+	}{
+		{k: -1, want: nil},
+		{k: 0, want: [][]int{{}}},
+		{k: 1, want: [][]int{{1}, {2}, {3}, {4}}},
+		{k: 2, want: [][]int{{1, 2}, {1, 3}, {1, 4}, {2, 3}, {2, 4}, {3, 4}}},
+		{k: 3, want: [][]int{{1, 2, 3}, {1, 2, 4}, {1, 3, 4}, {2, 3, 4}}},
+		{k: 4, want: [][]int{{1, 2, 3, 4}}},
+		{k: 5, want: nil},
+	} {
+		t.Run(fmt.Sprintf("Combinations(%d,%v)", tc.k, from), func(t *testing.T) {
+			if got := collect(Combinations(tc.k, from)); !equal(got, tc.want) {
+				t.Errorf("want: %v, got: %v", tc.want, got)
+			}
+			if got := Choose(tc.k, from); !equal(got, tc.want) {
+				t.Errorf("Choose want: %v, got: %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestCombinationsWithReplacement(t *testing.T) {
+	got := collect(CombinationsWithReplacement(2, []int{1, 2, 3}))
+	want := [][]int{{1, 1}, {1, 2}, {1, 3}, {2, 2}, {2, 3}, {3, 3}}
+	if !equal(got, want) {
+		t.Errorf("want: %v, got: %v", want, got)
+	}
+
+	if got := collect(CombinationsWithReplacement(2, []int{})); got != nil {
+		t.Errorf("want: nil, got: %v", got)
+	}
+}
+
+func TestPermutations(t *testing.T) {
+	got := collect(Permutations(2, []int{1, 2, 3}))
+	want := [][]int{{1, 2}, {1, 3}, {2, 1}, {2, 3}, {3, 1}, {3, 2}}
+	if !equal(got, want) {
+		t.Errorf("want: %v, got: %v", want, got)
+	}
+
+	if got, want := len(collect(Permutations(4, []int{1, 2, 3, 4}))), 24; got != want {
+		t.Errorf("want %d permutations, got: %d", want, got)
+	}
+}
+
+func TestCartesianProduct(t *testing.T) {
+	got := collect(CartesianProduct([]int{1, 2}, []int{3}, []int{4, 5}))
+	want := [][]int{{1, 3, 4}, {1, 3, 5}, {2, 3, 4}, {2, 3, 5}}
+	if !equal(got, want) {
+		t.Errorf("want: %v, got: %v", want, got)
+	}
+
+	if got := collect(CartesianProduct([]int{1, 2}, []int{})); got != nil {
+		t.Errorf("want: nil, got: %v", got)
+	}
+}
+
+func TestPowerSet(t *testing.T) {
+	got := collect(PowerSet([]int{1, 2, 3}))
+	want := [][]int{{}, {1}, {2}, {3}, {1, 2}, {1, 3}, {2, 3}, {1, 2, 3}}
+	if !equal(got, want) {
+		t.Errorf("want: %v, got: %v", want, got)
+	}
+}
+
+func TestStopEarly(t *testing.T) {
+	from := []int{1, 2, 3, 4, 5}
+	for name, seq := range map[string]iter.Seq[[]int]{
+		"Combinations":                Combinations(2, from),
+		"CombinationsWithReplacement": CombinationsWithReplacement(2, from),
+		"Permutations":                Permutations(2, from),
+		"CartesianProduct":            CartesianProduct(from, from),
+		"PowerSet":                    PowerSet(from),
+	} {
+		t.Run(name, func(t *testing.T) {
+			count := 0
+			for range seq {
+				count++
+				if count == 3 {
+					break
+				}
+			}
+			if count != 3 {
+				t.Errorf("want 3 iterations, got: %d", count)
+			}
+		})
+	}
+}
+
+func TestReusesBuffer(t *testing.T) {
+	var first []int
+	for c := range Combinations(2, []int{1, 2, 3}) {
+		if first == nil {
+			first = c
+			continue
+		}
+		if &first[0] != &c[0] {
+			t.Errorf("expected the same buffer on every iteration")
+		}
+	}
+}
@@ -0,0 +1,216 @@
+package deque
+
+import (
+	"fmt"
+	"iter"
+)
+
+// Deque is a double ended queue backed by a growable ring buffer, adding or
+// removing elements at any of its ends is O(1) amortized
+type Deque[T any] struct {
+	buf  []T
+	head int
+	size int
+}
+
+func New[T any]() *Deque[T] {
+	return &Deque[T]{}
+}
+
+func WithCapacity[T any](capacity int) *Deque[T] {
+	return &Deque[T]{buf: make([]T, capacity)}
+}
+
+func FromSlice[T any](slice []T) *Deque[T] {
+	d := WithCapacity[T](len(slice))
+	for _, v := range slice {
+		d.PushBack(v)
+	}
+	return d
+}
+
+func (d *Deque[T]) Len() int {
+	return d.size
+}
+
+func (d *Deque[T]) Cap() int {
+	return len(d.buf)
+}
+
+func (d *Deque[T]) IsEmpty() bool {
+	return d.size == 0
+}
+
+func (d *Deque[T]) PushBack(v T) {
+	d.grow()
+	d.buf[d.index(d.size)] = v
+	d.size++
+}
+
+func (d *Deque[T]) PushFront(v T) {
+	d.grow()
+	d.head = d.index(len(d.buf) - 1)
+	d.buf[d.head] = v
+	d.size++
+}
+
+// PopFront removes and returns the first element, or false if the deque is
+// empty
+func (d *Deque[T]) PopFront() (T, bool) {
+	var zero T
+	if d.size == 0 {
+		return zero, false
+	}
+	v := d.buf[d.head]
+	d.buf[d.head] = zero
+	d.head = d.index(1)
+	d.size--
+	return v, true
+}
+
+// PopBack removes and returns the last element, or false if the deque is
+// empty
+func (d *Deque[T]) PopBack() (T, bool) {
+	var zero T
+	if d.size == 0 {
+		return zero, false
+	}
+	i := d.index(d.size - 1)
+	v := d.buf[i]
+	d.buf[i] = zero
+	d.size--
+	return v, true
+}
+
+// Front returns the first element, or false if the deque is empty
+func (d *Deque[T]) Front() (T, bool) {
+	if d.size == 0 {
+		var zero T
+		return zero, false
+	}
+	return d.buf[d.head], true
+}
+
+// Back returns the last element, or false if the deque is empty
+func (d *Deque[T]) Back() (T, bool) {
+	if d.size == 0 {
+		var zero T
+		return zero, false
+	}
+	return d.buf[d.index(d.size-1)], true
+}
+
+// At returns the i-th element counting from the front, it panics if i is out
+// of range
+func (d *Deque[T]) At(i int) T {
+	d.checkIndex(i)
+	return d.buf[d.index(i)]
+}
+
+// Set replaces the i-th element counting from the front, it panics if i is
+// out of range
+func (d *Deque[T]) Set(i int, v T) {
+	d.checkIndex(i)
+	d.buf[d.index(i)] = v
+}
+
+// Rotate moves the last n elements to the front, if n is negative it moves
+// the first -n elements to the back instead
+//
+// For example rotating [1 2 3 4 5] by 2 gives [4 5 1 2 3], and by -2 gives
+// [3 4 5 1 2]
+func (d *Deque[T]) Rotate(n int) {
+	if d.size <= 1 {
+		return
+	}
+	n %= d.size
+	if n == 0 {
+		return
+	}
+
+	if d.size == len(d.buf) {
+		// the buffer is full so moving the head is enough, n has to be
+		// positive so index only wraps the new head once
+		if n < 0 {
+			n += d.size
+		}
+		d.head = d.index(len(d.buf) - n)
+		return
+	}
+
+	for ; n > 0; n-- {
+		v, _ := d.PopBack()
+		d.PushFront(v)
+	}
+	for ; n < 0; n++ {
+		v, _ := d.PopFront()
+		d.PushBack(v)
+	}
+}
+
+func (d *Deque[T]) Clear() {
+	clear(d.buf)
+	d.head = 0
+	d.size = 0
+}
+
+// All iterates over the elements from the front to the back
+func (d *Deque[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := range d.size {
+			if !yield(i, d.buf[d.index(i)]) {
+				return
+			}
+		}
+	}
+}
+
+// Backward iterates over the elements from the back to the front
+func (d *Deque[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := d.size - 1; i >= 0; i-- {
+			if !yield(i, d.buf[d.index(i)]) {
+				return
+			}
+		}
+	}
+}
+
+// Slice returns a copy of the elements from the front to the back
+func (d *Deque[T]) Slice() []T {
+	r := make([]T, d.size)
+	n := copy(r, d.buf[d.head:min(d.head+d.size, len(d.buf))])
+	copy(r[n:], d.buf)
+	return r
+}
+
+func (d *Deque[T]) String() string {
+	return fmt.Sprint(d.Slice())
+}
+
+// index returns the position in the buffer of the i-th element counting from
+// the head, i must be in [0, len(buf))
+func (d *Deque[T]) index(i int) int {
+	i += d.head
+	if i >= len(d.buf) {
+		i -= len(d.buf)
+	}
+	return i
+}
+
+func (d *Deque[T]) grow() {
+	if d.size < len(d.buf) {
+		return
+	}
+	buf := make([]T, max(8, 2*len(d.buf)))
+	n := copy(buf, d.buf[d.head:])
+	copy(buf[n:], d.buf[:d.head])
+	d.buf = buf
+	d.head = 0
+}
+
+func (d *Deque[T]) checkIndex(i int) {
+	if i < 0 || i >= d.size {
+		panic(fmt.Sprintf("deque: index %d out of range [0, %d)", i, d.size))
+	}
+}
@@ -0,0 +1,220 @@
+package deque
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+// TestDeque applies random operations to a deque and to a slice and checks
+// that both always have the same elements
+func TestDeque(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	d := New[int]()
+	var model []int
+
+	for step := range 5000 {
+		switch op := r.IntN(7); op {
+		case 0, 1:
+			d.PushBack(step)
+			model = append(model, step)
+		case 2:
+			d.PushFront(step)
+			model = slices.Insert(model, 0, step)
+		case 3:
+			got, ok := d.PopFront()
+			if ok != (len(model) > 0) {
+				t.Fatalf("step %d: PopFront ok: %v, len: %d", step, ok, len(model))
+			}
+			if ok {
+				if got != model[0] {
+					t.Fatalf("step %d: PopFront want: %d, got: %d", step, model[0], got)
+				}
+				model = model[1:]
+			}
+		case 4:
+			got, ok := d.PopBack()
+			if ok != (len(model) > 0) {
+				t.Fatalf("step %d: PopBack ok: %v, len: %d", step, ok, len(model))
+			}
+			if ok {
+				if want := model[len(model)-1]; got != want {
+					t.Fatalf("step %d: PopBack want: %d, got: %d", step, want, got)
+				}
+				model = model[:len(model)-1]
+			}
+		case 5:
+			n := r.IntN(21) - 10
+			d.Rotate(n)
+			model = rotate(model, n)
+		case 6:
+			if len(model) > 0 {
+				i := r.IntN(len(model))
+				d.Set(i, -step)
+				model[i] = -step
+			}
+		}
+
+		if got := d.Slice(); !slices.Equal(got, model) {
+			t.Fatalf("step %d: want: %v, got: %v", step, model, got)
+		}
+		if d.Len() != len(model) {
+			t.Fatalf("step %d: want len: %d, got: %d", step, len(model), d.Len())
+		}
+		for i, v := range model {
+			if got := d.At(i); got != v {
+				t.Fatalf("step %d: At(%d) want: %d, got: %d", step, i, v, got)
+			}
+		}
+		if len(model) > 0 {
+			if front, _ := d.Front(); front != model[0] {
+				t.Fatalf("step %d: Front want: %d, got: %d", step, model[0], front)
+			}
+			if back, _ := d.Back(); back != model[len(model)-1] {
+				t.Fatalf("step %d: Back want: %d, got: %d", step, model[len(model)-1], back)
+			}
+		}
+	}
+}
+
+// rotate moves the last n elements of s to the front
+func rotate(s []int, n int) []int {
+	if len(s) == 0 {
+		return s
+	}
+	n = ((n % len(s)) + len(s)) % len(s)
+	return append(slices.Clone(s[len(s)-n:]), s[:len(s)-n]...)
+}
+
+func TestRotate(t *testing.T) {
+	for _, tc := range []struct {
+		n    int
+		want []int
+	}{
+		{n: 0, want: []int{1, 2, 3, 4, 5}},
+		{n: 2, want: []int{4, 5, 1, 2, 3}},
+		{n: -2, want: []int{3, 4, 5, 1, 2}},
+		{n: 7, want: []int{4, 5, 1, 2, 3}},
+		{n: -5, want: []int{1, 2, 3, 4, 5}},
+		{n: -4, want: []int{5, 1, 2, 3, 4}},
+		{n: -7, want: []int{3, 4, 5, 1, 2}},
+	} {
+		t.Run(fmt.Sprintf("Rotate(%d)", tc.n), func(t *testing.T) {
+			full := FromSlice([]int{1, 2, 3, 4, 5})
+			full.Rotate(tc.n)
+			if got := full.Slice(); !slices.Equal(got, tc.want) {
+				t.Errorf("full buffer want: %v, got: %v", tc.want, got)
+			}
+
+			// a full buffer with its head at the last slot, so rotating
+			// has to wrap it
+			moved := FromSlice([]int{0, 0, 0, 0, 1})
+			for _, v := range []int{2, 3, 4, 5} {
+				moved.PopFront()
+				moved.PushBack(v)
+			}
+			moved.Rotate(tc.n)
+			if got := moved.Slice(); !slices.Equal(got, tc.want) {
+				t.Errorf("full buffer with moved head want: %v, got: %v", tc.want, got)
+			}
+
+			d := New[int]()
+			for _, v := range []int{1, 2, 3, 4, 5} {
+				d.PushBack(v)
+			}
+			d.Rotate(tc.n)
+			if got := d.Slice(); !slices.Equal(got, tc.want) {
+				t.Errorf("want: %v, got: %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestIteration(t *testing.T) {
+	d := New[int]()
+	for _, v := range []int{3, 4, 5} {
+		d.PushBack(v)
+	}
+	for _, v := range []int{2, 1} {
+		d.PushFront(v)
+	}
+
+	var forward, backward []int
+	for i, v := range d.All() {
+		if v != i+1 {
+			t.Errorf("All want: %d at %d, got: %d", i+1, i, v)
+		}
+		forward = append(forward, v)
+	}
+	for _, v := range d.Backward() {
+		backward = append(backward, v)
+		if v == 3 {
+			break
+		}
+	}
+
+	if want := []int{1, 2, 3, 4, 5}; !slices.Equal(forward, want) {
+		t.Errorf("want: %v, got: %v", want, forward)
+	}
+	if want := []int{5, 4, 3}; !slices.Equal(backward, want) {
+		t.Errorf("want: %v, got: %v", want, backward)
+	}
+}
+
+func TestEmpty(t *testing.T) {
+	d := New[string]()
+	if _, ok := d.PopFront(); ok {
+		t.Errorf("PopFront of an empty deque returned ok")
+	}
+	if _, ok := d.PopBack(); ok {
+		t.Errorf("PopBack of an empty deque returned ok")
+	}
+	if _, ok := d.Front(); ok {
+		t.Errorf("Front of an empty deque returned ok")
+	}
+	if _, ok := d.Back(); ok {
+		t.Errorf("Back of an empty deque returned ok")
+	}
+	d.Rotate(3)
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("At of an empty deque didn't panic")
+		}
+	}()
+	d.At(0)
+}
+
+func TestRing(t *testing.T) {
+	r := NewRing[int](3)
+	var overwritten []int
+	for v := range 7 {
+		if old, ok := r.Push(v); ok {
+			overwritten = append(overwritten, old)
+		}
+	}
+
+	if want := []int{4, 5, 6}; !slices.Equal(r.Slice(), want) {
+		t.Errorf("want: %v, got: %v", want, r.Slice())
+	}
+	if want := []int{0, 1, 2, 3}; !slices.Equal(overwritten, want) {
+		t.Errorf("want overwritten: %v, got: %v", want, overwritten)
+	}
+	if !r.IsFull() || r.At(0) != 4 || r.At(2) != 6 {
+		t.Errorf("want a full ring from 4 to 6, got: %v", r.Slice())
+	}
+
+	if v, ok := r.PopOldest(); !ok || v != 4 {
+		t.Errorf("want PopOldest: 4, got: %d, %v", v, ok)
+	}
+	r.Push(7)
+	if want := []int{5, 6, 7}; !slices.Equal(r.Slice(), want) {
+		t.Errorf("want: %v, got: %v", want, r.Slice())
+	}
+
+	r.Clear()
+	if _, ok := r.PopOldest(); ok || r.Len() != 0 {
+		t.Errorf("want an empty ring after Clear, got: %v", r.Slice())
+	}
+}
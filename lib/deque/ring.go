@@ -0,0 +1,98 @@
+package deque
+
+import (
+	"fmt"
+	"iter"
+)
+
+// Ring is a queue with a fixed capacity, once it is full pushing an element
+// overwrites the oldest one, which makes it useful to keep the last n
+// elements of a sliding window
+type Ring[T any] struct {
+	buf  []T
+	head int
+	size int
+}
+
+func NewRing[T any](capacity int) *Ring[T] {
+	if capacity < 1 {
+		panic(fmt.Sprintf("deque: invalid ring capacity %d", capacity))
+	}
+	return &Ring[T]{buf: make([]T, capacity)}
+}
+
+func (r *Ring[T]) Len() int {
+	return r.size
+}
+
+func (r *Ring[T]) Cap() int {
+	return len(r.buf)
+}
+
+func (r *Ring[T]) IsFull() bool {
+	return r.size == len(r.buf)
+}
+
+// Push adds v as the newest element, if the ring is full the oldest element
+// is overwritten and returned with true
+func (r *Ring[T]) Push(v T) (T, bool) {
+	if r.size < len(r.buf) {
+		r.buf[(r.head+r.size)%len(r.buf)] = v
+		r.size++
+		var zero T
+		return zero, false
+	}
+	old := r.buf[r.head]
+	r.buf[r.head] = v
+	r.head = (r.head + 1) % len(r.buf)
+	return old, true
+}
+
+// PopOldest removes and returns the oldest element, or false if the ring is
+// empty
+func (r *Ring[T]) PopOldest() (T, bool) {
+	var zero T
+	if r.size == 0 {
+		return zero, false
+	}
+	v := r.buf[r.head]
+	r.buf[r.head] = zero
+	r.head = (r.head + 1) % len(r.buf)
+	r.size--
+	return v, true
+}
+
+// At returns the i-th element counting from the oldest one, it panics if i is
+// out of range
+func (r *Ring[T]) At(i int) T {
+	if i < 0 || i >= r.size {
+		panic(fmt.Sprintf("deque: index %d out of range [0, %d)", i, r.size))
+	}
+	return r.buf[(r.head+i)%len(r.buf)]
+}
+
+func (r *Ring[T]) Clear() {
+	clear(r.buf)
+	r.head = 0
+	r.size = 0
+}
+
+// All iterates over the elements from the oldest to the newest
+func (r *Ring[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := range r.size {
+			if !yield(i, r.buf[(r.head+i)%len(r.buf)]) {
+				return
+			}
+		}
+	}
+}
+
+// Slice returns a copy of the elements from the oldest to the newest
+func (r *Ring[T]) Slice() []T {
+	s := make([]T, r.size)
+	for i, v := range r.All() {
+		s[i] = v
+	}
+	return s
+}
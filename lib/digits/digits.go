@@ -0,0 +1,122 @@
+package digits
+
+// pow10[n] is 10^n, for every power of 10 that fits in an int64
+var pow10 = [...]int{
+	1, 10, 100, 1_000, 10_000, 100_000, 1_000_000, 10_000_000, 100_000_000,
+	1_000_000_000, 10_000_000_000, 100_000_000_000, 1_000_000_000_000,
+	10_000_000_000_000, 100_000_000_000_000, 1_000_000_000_000_000,
+	10_000_000_000_000_000, 100_000_000_000_000_000,
+	1_000_000_000_000_000_000,
+}
+
+// Count returns the amount of decimal digits of n, ignoring its sign
+//
+// Count(0) is 1
+func Count(n int) int {
+	return CountBase(n, 10)
+}
+
+// CountBase returns the amount of digits of n in the given base, ignoring its
+// sign
+func CountBase(n, base int) int {
+	checkBase(base)
+	u, b := abs(n), uint64(base)
+	count := 1
+	for u >= b {
+		u /= b
+		count++
+	}
+	return count
+}
+
+// Digits returns the decimal digits of n, ignoring its sign, from the most
+// significant to the least significant
+//
+// For example Digits(1203) is [1 2 0 3]
+func Digits(n int) []int {
+	return ToBase(n, 10)
+}
+
+// FromDigits composes the decimal digits, from the most significant to the
+// least significant, back into a number
+//
+// For example FromDigits([]int{1, 2, 0, 3}) is 1203
+func FromDigits(digits []int) int {
+	return FromBase(digits, 10)
+}
+
+// ToBase returns the digits of n in the given base, ignoring its sign, from
+// the most significant to the least significant
+func ToBase(n, base int) []int {
+	digits := make([]int, CountBase(n, base))
+	u, b := abs(n), uint64(base)
+	for i := len(digits) - 1; i >= 0; i-- {
+		digits[i] = int(u % b)
+		u /= b
+	}
+	return digits
+}
+
+// FromBase composes the digits in the given base, from the most significant
+// to the least significant, back into a number
+func FromBase(digits []int, base int) int {
+	checkBase(base)
+	n := 0
+	for _, d := range digits {
+		n = n*base + d
+	}
+	return n
+}
+
+// Reverse returns n with its decimal digits in reverse order, keeping its
+// sign, so Reverse(-120) is -21
+func Reverse(n int) int {
+	r := 0
+	for m := n; m != 0; m /= 10 {
+		r = r*10 + m%10
+	}
+	return r
+}
+
+// IsPalindrome reports whether the decimal digits of n, ignoring its sign,
+// read the same in both directions
+func IsPalindrome(n int) bool {
+	return abs(n) == abs(Reverse(n))
+}
+
+// Chunks splits the decimal digits of n, ignoring its sign, in chunks of size
+// digits each and returns them as numbers
+//
+// If the amount of digits of n is not a multiple of size Chunks returns nil.
+// For example Chunks(123456, 2) is [12 34 56], but Chunks(12345, 2) is nil.
+// Chunks starting with zeroes lose them: Chunks(1001, 2) is [10 1]
+func Chunks(n, size int) []int {
+	count := Count(n)
+	if size < 1 || count%size != 0 {
+		return nil
+	}
+	if size == count {
+		return []int{int(abs(n))}
+	}
+	chunks := make([]int, count/size)
+	u := abs(n)
+	for i := len(chunks) - 1; i >= 0; i-- {
+		chunks[i] = int(u % uint64(pow10[size]))
+		u /= uint64(pow10[size])
+	}
+	return chunks
+}
+
+func abs(n int) uint64 {
+	u := uint64(n)
+	if n < 0 {
+		u = -u
+	}
+	return u
+}
+
+func checkBase(base int) {
+	if base < 2 {
+		panic("digits: base must be at least 2")
+	}
+}
@@ -0,0 +1,159 @@
+package digits
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"strconv"
+	"testing"
+)
+
+func TestChunks(t *testing.T) {
+	for _, tc := range []struct {
+		n    int
+		size int
+		want []int
+	}{
+		{n: 1, size: 0, want: nil},
+		{n: 1, size: 1, want: []int{1}},
+		{n: 1, size: 2, want: nil},
+		{n: 1, size: 3, want: nil},
+		{n: 1, size: 4, want: nil},
+		{n: 1, size: 5, want: nil},
+		{n: 12, size: 0, want: nil},
+		{n: 12, size: 1, want: []int{1, 2}},
+		{n: 12, size: 2, want: []int{12}},
+		{n: 12, size: 3, want: nil},
+		{n: 12, size: 4, want: nil},
+		{n: 12, size: 5, want: nil},
+		{n: 123, size: 0, want: nil},
+		{n: 123, size: 1, want: []int{1, 2, 3}},
+		{n: 123, size: 2, want: nil},
+		{n: 123, size: 3, want: []int{123}},
+		{n: 123, size: 4, want: nil},
+		{n: 123, size: 5, want: nil},
+		{n: 1234, size: 0, want: nil},
+		{n: 1234, size: 1, want: []int{1, 2, 3, 4}},
+		{n: 1234, size: 2, want: []int{12, 34}},
+		{n: 1234, size: 3, want: nil},
+		{n: 1234, size: 4, want: []int{1234}},
+		{n: 12345, size: 0, want: nil},
+		{n: 12345, size: 1, want: []int{1, 2, 3, 4, 5}},
+		{n: 12345, size: 2, want: nil},
+		{n: 12345, size: 3, want: nil},
+		{n: 12345, size: 4, want: nil},
+		{n: 12345, size: 5, want: []int{12345}},
+		{n: 123456, size: 0, want: nil},
+		{n: 123456, size: 1, want: []int{1, 2, 3, 4, 5, 6}},
+		{n: 123456, size: 2, want: []int{12, 34, 56}},
+		{n: 123456, size: 3, want: []int{123, 456}},
+		{n: 123456, size: 4, want: nil},
+		{n: 123456, size: 5, want: nil},
+		{n: 123456, size: 6, want: []int{123456}},
+		{n: 123456789, size: -40, want: nil},
+		{n: 123456789, size: 0, want: nil},
+		{n: 123456789, size: 1, want: []int{1, 2, 3, 4, 5, 6, 7, 8, 9}},
+		{n: 123456789, size: 2, want: nil},
+		{n: 123456789, size: 3, want: []int{123, 456, 789}},
+		{n: 123456789, size: 4, want: nil},
+		{n: 123456789, size: 5, want: nil},
+		{n: 123456789, size: 6, want: nil},
+		{n: 123456789, size: 9, want: []int{123456789}},
+		{n: 123456789, size: 100, want: nil},
+		{n: 1001, size: 2, want: []int{10, 1}},
+		{n: -123456, size: 3, want: []int{123, 456}},
+	} {
+		t.Run(fmt.Sprintf("Chunks(%d,%d)", tc.n, tc.size), func(t *testing.T) {
+			got := Chunks(tc.n, tc.size)
+			if !slices.Equal(got, tc.want) {
+				t.Errorf("want: %v, got: %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestDigits(t *testing.T) {
+	for _, tc := range []struct {
+		n    int
+		want []int
+	}{
+		{n: 0, want: []int{0}},
+		{n: 7, want: []int{7}},
+		{n: 1203, want: []int{1, 2, 0, 3}},
+		{n: -1203, want: []int{1, 2, 0, 3}},
+	} {
+		t.Run(fmt.Sprintf("Digits(%d)", tc.n), func(t *testing.T) {
+			got := Digits(tc.n)
+			if !slices.Equal(got, tc.want) {
+				t.Errorf("want: %v, got: %v", tc.want, got)
+			}
+			if back := FromDigits(got); back != max(tc.n, -tc.n) {
+				t.Errorf("FromDigits(%v) got %d; want: %d", got, back, max(tc.n, -tc.n))
+			}
+		})
+	}
+}
+
+func TestBase(t *testing.T) {
+	r := rand.New(rand.NewPCG(7, 8))
+	for range 1000 {
+		n := r.IntN(1 << 40)
+		base := 2 + r.IntN(35)
+
+		got := ToBase(n, base)
+		want := strconv.FormatInt(int64(n), base)
+		if len(got) != len(want) || len(got) != CountBase(n, base) {
+			t.Fatalf("ToBase(%d, %d) got %v; want: %s", n, base, got, want)
+		}
+		for i, d := range got {
+			if strconv.FormatInt(int64(d), base) != want[i:i+1] {
+				t.Fatalf("ToBase(%d, %d) got %v; want: %s", n, base, got, want)
+			}
+		}
+		if back := FromBase(got, base); back != n {
+			t.Fatalf("FromBase(%v, %d) got %d; want: %d", got, base, back, n)
+		}
+	}
+}
+
+func TestReverseAndPalindrome(t *testing.T) {
+	for _, tc := range []struct {
+		n          int
+		reverse    int
+		palindrome bool
+	}{
+		{n: 0, reverse: 0, palindrome: true},
+		{n: 5, reverse: 5, palindrome: true},
+		{n: 12, reverse: 21, palindrome: false},
+		{n: 120, reverse: 21, palindrome: false},
+		{n: -120, reverse: -21, palindrome: false},
+		{n: 12321, reverse: 12321, palindrome: true},
+		{n: -1221, reverse: -1221, palindrome: true},
+	} {
+		if got := Reverse(tc.n); got != tc.reverse {
+			t.Errorf("Reverse(%d) got %d; want: %d", tc.n, got, tc.reverse)
+		}
+		if got := IsPalindrome(tc.n); got != tc.palindrome {
+			t.Errorf("IsPalindrome(%d) got %v; want: %v", tc.n, got, tc.palindrome)
+		}
+	}
+}
+
+func TestCount(t *testing.T) {
+	for _, tc := range []struct {
+		n, want int
+	}{
+		{n: 0, want: 1},
+		{n: 9, want: 1},
+		{n: 10, want: 2},
+		{n: -10, want: 2},
+		{n: 999_999, want: 6},
+		{n: 1_000_000, want: 7},
+		{n: 9_223_372_036_854_775_807, want: 19},
+		{n: -9_223_372_036_854_775_808, want: 19},
+	} {
+		if got := Count(tc.n); got != tc.want {
+			t.Errorf("Count(%d) got %d; want: %d", tc.n, got, tc.want)
+		}
+	}
+}
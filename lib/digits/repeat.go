@@ -0,0 +1,107 @@
+package digits
+
+import "iter"
+
+// Repeated iterates over every number in [low, high] made only of a block of
+// digits repeated exactly the given amount of times, like 6464 (64 twice) or
+// 111 (1 three times)
+//
+// Instead of checking every number in the range, the numbers are built from
+// their blocks: a block b of k digits repeated r times is b * (1 + 10^k +
+// 10^2k + ... + 10^(r-1)k), for example 6464 is 64 * 101. So only the numbers
+// that are yielded are ever computed.
+//
+// The numbers are yielded in increasing order
+func Repeated(low, high, times int) iter.Seq[int] {
+	return func(yield func(int) bool) {
+		if times < 1 {
+			return
+		}
+		low = max(low, 1)
+		for d := Count(low); d <= Count(high) && low <= high; d++ {
+			if d%times != 0 {
+				continue
+			}
+			if !yieldBlocks(low, high, d/times, times, false, yield) {
+				return
+			}
+		}
+	}
+}
+
+// RepeatedAtLeast iterates over every number in [low, high] made only of a
+// block of digits repeated at least the given amount of times, each number is
+// yielded only once even if it can be made in more than one way (222222 is 2
+// six times, 22 three times and 222 twice)
+//
+// To avoid duplicates, every number is built only from its smallest block,
+// which is the only block that is not itself made of a repeated block. The
+// numbers are yielded in increasing order of digits, but not necessarily in
+// increasing order within the same amount of digits
+func RepeatedAtLeast(low, high, times int) iter.Seq[int] {
+	return func(yield func(int) bool) {
+		if times < 1 {
+			return
+		}
+		low = max(low, 1)
+		for d := Count(low); d <= Count(high) && low <= high; d++ {
+			for k := 1; k*times <= d; k++ {
+				if d%k != 0 {
+					continue
+				}
+				if !yieldBlocks(low, high, k, d/k, true, yield) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// yieldBlocks yields every number in [low, high] made of a block of k digits
+// repeated r times, if onlyPrimitive is true the blocks that are themselves
+// made of a repeated block are skipped
+func yieldBlocks(low, high, k, r int, onlyPrimitive bool, yield func(int) bool) bool {
+	m := repunit(k, r)
+
+	// the smallest and biggest blocks of k digits that keep b * m in range
+	first := low / m
+	if low%m != 0 {
+		first++
+	}
+	first = max(first, pow10[k-1])
+	last := high / m
+	if k < len(pow10) {
+		last = min(last, pow10[k]-1)
+	}
+
+	for b := first; b <= last; b++ {
+		if onlyPrimitive && !isPrimitive(b, k) {
+			continue
+		}
+		if !yield(b * m) {
+			return false
+		}
+	}
+	return true
+}
+
+// repunit returns 1 + 10^k + 10^2k + ... + 10^(r-1)k, which multiplied by a
+// block of k digits gives that block repeated r times
+func repunit(k, r int) int {
+	m := 0
+	for i := range r {
+		m += pow10[i*k]
+	}
+	return m
+}
+
+// isPrimitive reports whether the block b of k digits is not made of a
+// smaller block repeated
+func isPrimitive(b, k int) bool {
+	for j := 1; j < k; j++ {
+		if k%j == 0 && b%repunit(j, k/j) == 0 {
+			return false
+		}
+	}
+	return true
+}
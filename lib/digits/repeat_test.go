@@ -0,0 +1,75 @@
+package digits
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// repeats returns, by brute force, the biggest amount of times a block is
+// repeated to make n
+func repeats(n int) int {
+	s := strconv.Itoa(n)
+	for k := 1; k <= len(s); k++ {
+		if len(s)%k == 0 && strings.Repeat(s[:k], len(s)/k) == s {
+			return len(s) / k
+		}
+	}
+	return 1
+}
+
+func isRepeated(n, times int) bool {
+	s := strconv.Itoa(n)
+	if len(s)%times != 0 {
+		return false
+	}
+	k := len(s) / times
+	return strings.Repeat(s[:k], times) == s
+}
+
+func TestRepeatedAgainstBruteForce(t *testing.T) {
+	r := rand.New(rand.NewPCG(5, 6))
+
+	for range 200 {
+		low := r.IntN(2_000_000)
+		high := low + r.IntN(20_000)
+		times := 1 + r.IntN(4)
+
+		t.Run(fmt.Sprintf("[%d, %d] x%d", low, high, times), func(t *testing.T) {
+			var want, wantAtLeast []int
+			for n := max(low, 1); n <= high; n++ {
+				if isRepeated(n, times) {
+					want = append(want, n)
+				}
+				if repeats(n) >= times {
+					wantAtLeast = append(wantAtLeast, n)
+				}
+			}
+
+			got := slices.Collect(Repeated(low, high, times))
+			if !slices.Equal(got, want) {
+				t.Errorf("Repeated want: %v, got: %v", want, got)
+			}
+
+			gotAtLeast := slices.Collect(RepeatedAtLeast(low, high, times))
+			slices.Sort(gotAtLeast)
+			if !slices.Equal(gotAtLeast, wantAtLeast) {
+				t.Errorf("RepeatedAtLeast want: %v, got: %v", wantAtLeast, gotAtLeast)
+			}
+		})
+	}
+}
+
+func TestRepeatedWideRange(t *testing.T) {
+	// every block of up to 5 digits repeated twice is in range
+	count := 0
+	for range Repeated(1, 9_999_999_999, 2) {
+		count++
+	}
+	if want := 9 + 90 + 900 + 9_000 + 90_000; count != want {
+		t.Errorf("got %d numbers; want: %d", count, want)
+	}
+}
@@ -0,0 +1,64 @@
+// Package gen checks solvers against slow references with random inputs, so
+// they are tested with more than the example of each puzzle
+//
+// The inputs come from a fixed seed, so failures can be reproduced, run the
+// tests with -seed to try other inputs
+package gen
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+var seed = flag.Uint64("seed", 1, "seed of the random inputs of the solvers")
+
+// Input writes to w a random valid input of a puzzle
+type Input func(r *rand.Rand, w io.Writer)
+
+// Solver returns the answer to the input in filename, like the parts do
+type Solver func(filename string) int
+
+// Reference returns the answer to input, it should be slow and obviously
+// correct instead of clever
+type Reference func(input string) int
+
+// Check writes n inputs with input and fails t with the first one where solve
+// and reference give different answers, in short mode it only writes a tenth
+// of them
+func Check(t testing.TB, n int, input Input, solve Solver, reference Reference) {
+	t.Helper()
+
+	if testing.Short() {
+		n = max(1, n/10)
+	}
+
+	filename := filepath.Join(t.TempDir(), "input")
+	for i := range n {
+		var buf strings.Builder
+		input(rand.New(rand.NewPCG(*seed, uint64(i))), &buf)
+		if err := os.WriteFile(filename, []byte(buf.String()), 0o644); err != nil {
+			t.Fatalf("can't write input %d: %v", i, err)
+		}
+
+		got := solve(filename)
+		want := reference(buf.String())
+		if got != want {
+			t.Fatalf("input %d of seed %d got %d; want: %d\n%s", i, *seed, got, want, head(buf.String(), 30))
+		}
+	}
+}
+
+// head returns the first n lines of s, saying how many were left out
+func head(s string, n int) string {
+	lines := strings.SplitAfter(strings.TrimSuffix(s, "\n"), "\n")
+	if len(lines) <= n {
+		return s
+	}
+	return strings.Join(lines[:n], "") + fmt.Sprintf("... %d more lines\n", len(lines)-n)
+}
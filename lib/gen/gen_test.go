@@ -0,0 +1,98 @@
+package gen
+
+import (
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"os"
+	"runtime"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// numbers writes between 1 and 50 numbers, one per line
+func numbers(r *rand.Rand, w io.Writer) {
+	for range 1 + r.IntN(50) {
+		fmt.Fprintln(w, r.IntN(100))
+	}
+}
+
+func sum(input string) int {
+	total := 0
+	for _, line := range strings.Fields(input) {
+		n, _ := strconv.Atoi(line)
+		total += n
+	}
+	return total
+}
+
+func sumFile(filename string) int {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		panic(err)
+	}
+	return sum(string(data))
+}
+
+func TestCheck(t *testing.T) {
+	Check(t, 100, numbers, sumFile, sum)
+}
+
+// recorder is a testing.TB that keeps the errors instead of failing
+type recorder struct {
+	testing.TB
+	errors []string
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Fatalf(format string, args ...any) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+	runtime.Goexit()
+}
+
+func TestCheckFails(t *testing.T) {
+	inputs := 0
+	wrong := func(filename string) int {
+		inputs++
+		if n := sumFile(filename); n < 2000 {
+			return n
+		}
+		return -1
+	}
+
+	r := &recorder{TB: t}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		Check(r, 1000, numbers, wrong, sum)
+	}()
+	<-done
+
+	if len(r.errors) != 1 || inputs == 1000 {
+		t.Fatalf("want: a failure before the last input, got: %q after %d inputs", r.errors, inputs)
+	}
+	if want := fmt.Sprintf("input %d of seed 1 got -1", inputs-1); !strings.HasPrefix(r.errors[0], want) {
+		t.Errorf("want: %q, got: %q", want, r.errors[0])
+	}
+}
+
+func TestHead(t *testing.T) {
+	tests := []struct {
+		s    string
+		n    int
+		want string
+	}{
+		{"a\nb\n", 2, "a\nb\n"},
+		{"a\nb\nc\n", 2, "a\nb\n... 1 more lines\n"},
+		{"a\nb\nc\nd", 1, "a\n... 3 more lines\n"},
+	}
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("head(%q, %d)", test.s, test.n), func(t *testing.T) {
+			if got := head(test.s, test.n); got != test.want {
+				t.Errorf("want: %q, got: %q", test.want, got)
+			}
+		})
+	}
+}
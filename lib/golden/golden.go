@@ -0,0 +1,127 @@
+// Package golden compares the grids of tests with the ones saved in golden
+// files, like the *_want.test files of the inputs
+//
+// Running the tests with -update writes the grids to their golden files
+// instead of comparing them, review the changes before committing them
+package golden
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/unkiwii/aoc/lib/render"
+)
+
+var update = flag.Bool("update", false, "write the grids of the tests to their golden files")
+
+// Text returns the rows of g as lines of text, each one ending with a new line
+func Text(g render.Grid) string {
+	var buf strings.Builder
+	width, height := g.Size()
+	for y := range height {
+		for x := range width {
+			buf.WriteByte(g.At(x, y))
+		}
+		buf.WriteByte('\n')
+	}
+	return buf.String()
+}
+
+// Grid compares g with the grid saved in filename, failing t with a diff of
+// both if they are not equal, or with -update it writes g to filename
+func Grid(t testing.TB, filename string, g render.Grid) {
+	t.Helper()
+
+	got := Text(g)
+	if *update {
+		if err := os.WriteFile(filename, []byte(got), 0o644); err != nil {
+			t.Fatalf("can't update golden file %q: %v", filename, err)
+		}
+		return
+	}
+
+	want, err := os.ReadFile(filename)
+	if err != nil {
+		t.Fatalf("can't read golden file %q: %v (run with -update to create it)", filename, err)
+	}
+	if diff := Diff(lines(string(want)), lines(got)); diff != "" {
+		t.Errorf("grid differs from golden file %q (run with -update to accept it):\n%s", filename, diff)
+	}
+}
+
+func lines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+}
+
+// Diff returns the rows of want and got side by side, with a third column
+// that marks with * the cells that differ and with > the rows that have them,
+// or an empty string if both are equal
+//
+// Rows or cells that are missing in one of them are shown as spaces and count
+// as different
+func Diff(want, got []string) string {
+	height := max(len(want), len(got))
+	width := 0
+	for y := range height {
+		width = max(width, len(row(want, y)), len(row(got, y)))
+	}
+
+	// the columns are at least as wide as their titles
+	col := max(width, len("want"))
+
+	var buf strings.Builder
+	cells := 0
+	firstX, firstY := -1, -1
+	for y := range height {
+		w, g := row(want, y), row(got, y)
+
+		var mark strings.Builder
+		for x := range width {
+			if cell(w, x) == cell(g, x) {
+				mark.WriteByte(' ')
+				continue
+			}
+			mark.WriteByte('*')
+			if cells == 0 {
+				firstX, firstY = x, y
+			}
+			cells++
+		}
+
+		prefix := "  "
+		if strings.Contains(mark.String(), "*") {
+			prefix = "> "
+		}
+		line := fmt.Sprintf("%s%-*s  %-*s  %s", prefix, col, w, col, g, mark.String())
+		buf.WriteString(strings.TrimRight(line, " "))
+		buf.WriteByte('\n')
+	}
+
+	if cells == 0 {
+		return ""
+	}
+
+	header := fmt.Sprintf("  %-*s  %-*s  diff\n", col, "want", col, "got")
+	summary := fmt.Sprintf("%d cells differ, the first one at %d,%d\n", cells, firstX, firstY)
+	return header + buf.String() + summary
+}
+
+func row(rows []string, y int) string {
+	if y < len(rows) {
+		return rows[y]
+	}
+	return ""
+}
+
+func cell(row string, x int) byte {
+	if x < len(row) {
+		return row[x]
+	}
+	return ' '
+}
@@ -0,0 +1,116 @@
+package golden
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/unkiwii/aoc/lib/render"
+)
+
+func TestText(t *testing.T) {
+	g := render.Bytes{[]byte("#.."), []byte(".#.")}
+	want := "#..\n.#.\n"
+	if got := Text(g); got != want {
+		t.Errorf("want: %q, got: %q", want, got)
+	}
+}
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		want, got []string
+		diff      string
+	}{
+		{
+			want: []string{"..#", "#.."},
+			got:  []string{"..#", "#.."},
+			diff: "",
+		},
+		{
+			want: []string{"..#", "#..", "..."},
+			got:  []string{"..#", "##.", "..."},
+			diff: "" +
+				"  want  got   diff\n" +
+				"  ..#   ..#\n" +
+				"> #..   ##.    *\n" +
+				"  ...   ...\n" +
+				"1 cells differ, the first one at 1,1\n",
+		},
+		{
+			want: []string{"..#", "#.."},
+			got:  []string{"..#."},
+			diff: "" +
+				"  want  got   diff\n" +
+				"> ..#   ..#.     *\n" +
+				"> #..         ***\n" +
+				"4 cells differ, the first one at 3,0\n",
+		},
+	}
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("Diff(%q, %q)", test.want, test.got), func(t *testing.T) {
+			if got := Diff(test.want, test.got); got != test.diff {
+				t.Errorf("want:\n%s\ngot:\n%s", test.diff, got)
+			}
+		})
+	}
+}
+
+// recorder is a testing.TB that keeps the errors instead of failing
+type recorder struct {
+	testing.TB
+	errors []string
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Errorf(format string, args ...any) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func (r *recorder) Fatalf(format string, args ...any) {
+	r.Errorf(format, args...)
+	runtime.Goexit()
+}
+
+// grid calls Grid with a recorder in its own goroutine, so Fatalf can stop it
+func grid(t *testing.T, filename string, g render.Grid) []string {
+	r := &recorder{TB: t}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		Grid(r, filename, g)
+	}()
+	<-done
+	return r.errors
+}
+
+func TestGrid(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "grid_want.test")
+	g := render.Bytes{[]byte("#.."), []byte(".#.")}
+
+	if errors := grid(t, filename, g); len(errors) != 1 || !strings.Contains(errors[0], "-update") {
+		t.Fatalf("want: an error about the missing file, got: %q", errors)
+	}
+
+	*update = true
+	errors := grid(t, filename, g)
+	*update = false
+	if len(errors) != 0 {
+		t.Fatalf("want: no errors, got: %q", errors)
+	}
+	if got, err := os.ReadFile(filename); err != nil || string(got) != "#..\n.#.\n" {
+		t.Fatalf("want: the grid written to %q, got: %q, %v", filename, got, err)
+	}
+
+	if errors := grid(t, filename, g); len(errors) != 0 {
+		t.Errorf("want: no errors, got: %q", errors)
+	}
+
+	g[1][2] = '#'
+	if errors := grid(t, filename, g); len(errors) != 1 || !strings.Contains(errors[0], "> .#.   .##     *") {
+		t.Errorf("want: a diff of the grids, got: %q", errors)
+	}
+}
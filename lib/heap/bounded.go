@@ -0,0 +1,94 @@
+package heap
+
+import (
+	"cmp"
+	"container/heap"
+	"slices"
+)
+
+// Bounded collects the best k elements pushed to it using a heap of at most k
+// elements, so selecting them out of n elements takes O(n log k)
+//
+// The worst of the collected elements is kept at the top of the heap, that
+// way a new element only has to be compared with it to know if it is kept
+type Bounded[T any] struct {
+	h      *Heap[T]
+	k      int
+	better func(a, b T) bool
+}
+
+// TopK collects the k biggest elements
+func TopK[T cmp.Ordered](k int) *Bounded[T] {
+	return TopKWithLess(k, Less[T])
+}
+
+// TopKWithLess collects the k biggest elements according to less
+func TopKWithLess[T any](k int, less func(a, b T) bool) *Bounded[T] {
+	return &Bounded[T]{
+		h:      NewWithLess(less),
+		k:      k,
+		better: func(a, b T) bool { return less(b, a) },
+	}
+}
+
+// BottomK collects the k smallest elements
+func BottomK[T cmp.Ordered](k int) *Bounded[T] {
+	return BottomKWithLess(k, Less[T])
+}
+
+// BottomKWithLess collects the k smallest elements according to less
+func BottomKWithLess[T any](k int, less func(a, b T) bool) *Bounded[T] {
+	return &Bounded[T]{
+		h:      NewWithLess(func(a, b T) bool { return less(b, a) }),
+		k:      k,
+		better: less,
+	}
+}
+
+func (b *Bounded[T]) Len() int {
+	return b.h.Len()
+}
+
+// Push offers v to the collector and reports whether it was kept, it may
+// still be dropped later by a better element
+func (b *Bounded[T]) Push(v T) bool {
+	if b.k <= 0 {
+		return false
+	}
+	if b.h.Len() < b.k {
+		b.h.PushItem(v)
+		return true
+	}
+	if !b.better(v, b.h.data[0]) {
+		return false
+	}
+	b.h.data[0] = v
+	heap.Fix(b.h, 0)
+	return true
+}
+
+// Worst returns the worst of the collected elements, which is the one an
+// element has to beat to be kept once there are k of them, or false if
+// nothing was collected
+func (b *Bounded[T]) Worst() (T, bool) {
+	if b.h.IsEmpty() {
+		var zero T
+		return zero, false
+	}
+	return b.h.Peek(), true
+}
+
+// Slice returns the collected elements from the best to the worst
+func (b *Bounded[T]) Slice() []T {
+	r := b.h.Slice()
+	slices.SortStableFunc(r, func(x, y T) int {
+		switch {
+		case b.better(x, y):
+			return -1
+		case b.better(y, x):
+			return 1
+		}
+		return 0
+	})
+	return r
+}
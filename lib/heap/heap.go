@@ -3,6 +3,7 @@ package heap
 import (
 	"cmp"
 	"container/heap"
+	"iter"
 )
 
 func Less[T cmp.Ordered](a, b T) bool {
@@ -83,3 +84,23 @@ func (h *Heap[T]) Slice() []T {
 	copy(r, h.data)
 	return r
 }
+
+// Heapify builds a heap with the elements of data in O(n), the heap takes
+// ownership of data so it must not be used after this call
+func Heapify[T any](data []T, less func(a, b T) bool) *Heap[T] {
+	h := &Heap[T]{data: data, less: less}
+	heap.Init(h)
+	return h
+}
+
+// Drain iterates over the elements of the heap in order, removing them as
+// they are yielded, if the iteration stops early the rest stay in the heap
+func (h *Heap[T]) Drain() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for !h.IsEmpty() {
+			if !yield(h.PopItem()) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,132 @@
+package heap
+
+import (
+	"fmt"
+	"iter"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+func randomValues(r *rand.Rand, n int) []int {
+	values := make([]int, n)
+	for i := range values {
+		values[i] = r.IntN(20)
+	}
+	return values
+}
+
+func TestHeapifyAndDrain(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	for range 100 {
+		values := randomValues(r, r.IntN(30))
+		want := slices.Sorted(slices.Values(values))
+
+		h := Heapify(slices.Clone(values), Less[int])
+		if got := slices.Collect(h.Drain()); !slices.Equal(got, want) {
+			t.Fatalf("Heapify(%v) want: %v, got: %v", values, want, got)
+		}
+		if !h.IsEmpty() {
+			t.Fatalf("want an empty heap after draining, got: %v", h.Slice())
+		}
+	}
+}
+
+func TestDrainStopsEarly(t *testing.T) {
+	h := Heapify([]int{5, 1, 4, 2, 3}, Less[int])
+	for v := range h.Drain() {
+		if v == 2 {
+			break
+		}
+	}
+	if got, want := slices.Collect(h.Drain()), []int{3, 4, 5}; !slices.Equal(got, want) {
+		t.Errorf("want: %v, got: %v", want, got)
+	}
+}
+
+func TestTopKAndBottomK(t *testing.T) {
+	r := rand.New(rand.NewPCG(3, 4))
+	for range 200 {
+		values := randomValues(r, r.IntN(30))
+		k := r.IntN(6)
+		t.Run(fmt.Sprintf("K(%v,%d)", values, k), func(t *testing.T) {
+			sorted := slices.Sorted(slices.Values(values))
+
+			top, bottom := TopK[int](k), BottomK[int](k)
+			for _, v := range values {
+				top.Push(v)
+				bottom.Push(v)
+			}
+
+			n := min(k, len(values))
+			wantBottom := sorted[:n]
+			wantTop := slices.Clone(sorted[len(sorted)-n:])
+			slices.Reverse(wantTop)
+
+			if got := top.Slice(); !slices.Equal(got, wantTop) {
+				t.Errorf("TopK want: %v, got: %v", wantTop, got)
+			}
+			if got := bottom.Slice(); !slices.Equal(got, wantBottom) {
+				t.Errorf("BottomK want: %v, got: %v", wantBottom, got)
+			}
+			if worst, ok := top.Worst(); ok != (n > 0) || (ok && worst != wantTop[n-1]) {
+				t.Errorf("TopK want worst: %v, got: %v, %v", wantTop, worst, ok)
+			}
+		})
+	}
+}
+
+func TestTopKKeepsEqualElements(t *testing.T) {
+	top := TopK[int](3)
+	for _, size := range []int{5, 2, 5, 1, 5, 4} {
+		top.Push(size)
+	}
+	if got, want := top.Slice(), []int{5, 5, 5}; !slices.Equal(got, want) {
+		t.Errorf("want: %v, got: %v", want, got)
+	}
+	if top.Push(5) {
+		t.Errorf("want an equal element to not replace the worst one")
+	}
+}
+
+func TestMerge(t *testing.T) {
+	r := rand.New(rand.NewPCG(5, 6))
+	for range 100 {
+		var seqs [][]int
+		var want []int
+		for range r.IntN(5) {
+			values := slices.Sorted(slices.Values(randomValues(r, r.IntN(10))))
+			seqs = append(seqs, values)
+			want = append(want, values...)
+		}
+		slices.Sort(want)
+
+		iters := make([]iter.Seq[int], len(seqs))
+		for i, s := range seqs {
+			iters[i] = slices.Values(s)
+		}
+		if got := slices.Collect(Merge(Less[int], iters...)); !slices.Equal(got, want) {
+			t.Fatalf("Merge(%v) want: %v, got: %v", seqs, want, got)
+		}
+	}
+}
+
+func TestMergeIsStable(t *testing.T) {
+	type item struct{ key, seq int }
+	byKey := func(a, b item) bool { return a.key < b.key }
+
+	got := slices.Collect(Merge(byKey,
+		slices.Values([]item{{1, 0}, {2, 0}}),
+		slices.Values([]item{{1, 1}, {2, 1}, {3, 1}}),
+	))
+	want := []item{{1, 0}, {1, 1}, {2, 0}, {2, 1}, {3, 1}}
+	if !slices.Equal(got, want) {
+		t.Errorf("want: %v, got: %v", want, got)
+	}
+
+	for v := range Merge(Less[int], slices.Values([]int{1, 3}), slices.Values([]int{2})) {
+		if v == 2 {
+			break
+		}
+	}
+}
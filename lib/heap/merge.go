@@ -0,0 +1,51 @@
+package heap
+
+import "iter"
+
+// Merge iterates over the elements of every sequence in order, each sequence
+// must already be sorted according to less
+//
+// It only keeps the next element of each sequence, in a heap, so merging k
+// sequences with n elements in total takes O(n log k). Elements that are
+// equal come first from the sequences given first
+func Merge[T any](less func(a, b T) bool, seqs ...iter.Seq[T]) iter.Seq[T] {
+	type head struct {
+		value T
+		seq   int
+	}
+
+	return func(yield func(T) bool) {
+		nexts := make([]func() (T, bool), len(seqs))
+		for i, seq := range seqs {
+			next, stop := iter.Pull(seq)
+			defer stop()
+			nexts[i] = next
+		}
+
+		heads := make([]head, 0, len(seqs))
+		for i, next := range nexts {
+			if v, ok := next(); ok {
+				heads = append(heads, head{value: v, seq: i})
+			}
+		}
+
+		h := Heapify(heads, func(a, b head) bool {
+			if less(a.value, b.value) {
+				return true
+			}
+			if less(b.value, a.value) {
+				return false
+			}
+			return a.seq < b.seq
+		})
+		for !h.IsEmpty() {
+			top := h.PopItem()
+			if !yield(top.value) {
+				return
+			}
+			if v, ok := nexts[top.seq](); ok {
+				h.PushItem(head{value: v, seq: top.seq})
+			}
+		}
+	}
+}
@@ -0,0 +1,198 @@
+// Package input loads the inputs of the puzzles into typed values, so each
+// day doesn't have to write its own loop to read them
+//
+// Every loader returns an *Error that says in which file and line the input
+// couldn't be read
+package input
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Error is an error found loading an input
+type Error struct {
+	Filename string
+	Line     int // line where the error was found, the first one is 1, or 0 for the whole file
+	Err      error
+}
+
+func (e *Error) Error() string {
+	if e.Line == 0 {
+		return fmt.Sprintf("%s: %v", e.Filename, e.Err)
+	}
+	return fmt.Sprintf("%s:%d: %v", e.Filename, e.Line, e.Err)
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// maxLineSize is the longest line that can be read, some inputs are a single
+// very long line
+const maxLineSize = 1 << 20
+
+// Lines returns every line of filename without its new line
+func Lines(filename string) ([]string, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, &Error{Filename: filename, Err: err}
+	}
+	defer file.Close()
+
+	var lines []string
+	s := bufio.NewScanner(file)
+	s.Buffer(nil, maxLineSize)
+	for s.Scan() {
+		lines = append(lines, s.Text())
+	}
+	if err := s.Err(); err != nil {
+		return nil, &Error{Filename: filename, Line: len(lines) + 1, Err: err}
+	}
+	return lines, nil
+}
+
+// Ints returns the number in each line of filename
+func Ints(filename string) ([]int, error) {
+	lines, err := Lines(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	ints := make([]int, len(lines))
+	for i, line := range lines {
+		ints[i], err = atoi(line)
+		if err != nil {
+			return nil, &Error{Filename: filename, Line: i + 1, Err: err}
+		}
+	}
+	return ints, nil
+}
+
+// CommaInts returns the numbers of filename separated by commas, in one or
+// more lines
+func CommaInts(filename string) ([]int, error) {
+	lines, err := Lines(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	var ints []int
+	for i, line := range lines {
+		if line == "" {
+			continue
+		}
+		for j, field := range strings.Split(line, ",") {
+			n, err := atoi(field)
+			if err != nil {
+				return nil, &Error{Filename: filename, Line: i + 1, Err: fmt.Errorf("number %d: %w", j+1, err)}
+			}
+			ints = append(ints, n)
+		}
+	}
+	return ints, nil
+}
+
+// Sections returns the lines of filename split by blank lines, like the fresh
+// ranges and the ingredients of 2025 Day 5
+func Sections(filename string) ([][]string, error) {
+	lines, err := Lines(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	var sections [][]string
+	var section []string
+	for _, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			sections = append(sections, section)
+			section = nil
+			continue
+		}
+		section = append(section, line)
+	}
+	if section != nil {
+		sections = append(sections, section)
+	}
+	return sections, nil
+}
+
+// Grid returns the bytes of each line of filename, every line must be as wide
+// as the first one
+func Grid(filename string) ([][]byte, error) {
+	lines, err := Lines(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	grid := make([][]byte, len(lines))
+	for y, line := range lines {
+		if len(line) != len(lines[0]) {
+			return nil, &Error{Filename: filename, Line: y + 1, Err: fmt.Errorf("got %d columns; want: %d like the first line", len(line), len(lines[0]))}
+		}
+		grid[y] = []byte(line)
+	}
+	return grid, nil
+}
+
+// Scan parses each line of filename with format, like fmt.Sscanf does, into
+// a T, the whole line has to match the format
+//
+// If T is a struct each verb of format fills the next exported field, so
+// points like "162,817,812" are read with:
+//
+//	points, err := input.Scan[Point3D](filename, "%d,%d,%d")
+//
+// Otherwise format must have a single verb that fills the whole T
+func Scan[T any](filename, format string) ([]T, error) {
+	lines, err := Lines(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]T, len(lines))
+	for i, line := range lines {
+		if err := scan(line, format, &values[i]); err != nil {
+			return nil, &Error{Filename: filename, Line: i + 1, Err: err}
+		}
+	}
+	return values, nil
+}
+
+// scan parses line with format into the exported fields of the struct that
+// v points to, or into v itself
+func scan(line, format string, v any) error {
+	targets := []any{v}
+	if value := reflect.ValueOf(v).Elem(); value.Kind() == reflect.Struct {
+		targets = targets[:0]
+		for i := range value.NumField() {
+			if value.Type().Field(i).IsExported() {
+				targets = append(targets, value.Field(i).Addr().Interface())
+			}
+		}
+	}
+
+	r := strings.NewReader(line)
+	if _, err := fmt.Fscanf(r, format, targets...); err != nil {
+		return fmt.Errorf("can't scan %q as %q: %w", line, format, err)
+	}
+	if r.Len() != 0 {
+		return fmt.Errorf("can't scan %q as %q: unexpected %q at the end", line, format, line[len(line)-r.Len():])
+	}
+	return nil
+}
+
+// atoi is strconv.Atoi that ignores the spaces around the number and says
+// what it couldn't parse
+func atoi(s string) (int, error) {
+	n, err := strconv.Atoi(strings.TrimSpace(s))
+	if err != nil {
+		return 0, fmt.Errorf("can't parse %q as a number: %w", s, errors.Unwrap(err))
+	}
+	return n, nil
+}
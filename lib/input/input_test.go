@@ -0,0 +1,116 @@
+package input
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+// write writes content to a file in a temporary dir and returns its name
+func write(t *testing.T, content string) string {
+	t.Helper()
+	filename := filepath.Join(t.TempDir(), "input")
+	if err := os.WriteFile(filename, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return filename
+}
+
+// checkError checks that err is an *Error of filename at line
+func checkError(t *testing.T, err error, filename string, line int) {
+	t.Helper()
+	var e *Error
+	if !errors.As(err, &e) {
+		t.Fatalf("want: *Error, got: %v", err)
+	}
+	if e.Filename != filename || e.Line != line {
+		t.Errorf("want: error at %s:%d, got: %v", filename, line, err)
+	}
+}
+
+func TestLines(t *testing.T) {
+	got, err := Lines(write(t, "a b\n\ncd\r\n"))
+	if want := []string{"a b", "", "cd"}; err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("want: %q, got: %q, %v", want, got, err)
+	}
+
+	missing := filepath.Join(t.TempDir(), "missing")
+	_, err = Lines(missing)
+	checkError(t, err, missing, 0)
+	if !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("want: %v, got: %v", os.ErrNotExist, err)
+	}
+}
+
+func TestInts(t *testing.T) {
+	got, err := Ints(write(t, "1\n-20\n 3 \n"))
+	if want := []int{1, -20, 3}; err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("want: %v, got: %v, %v", want, got, err)
+	}
+
+	filename := write(t, "1\n2x\n")
+	_, err = Ints(filename)
+	checkError(t, err, filename, 2)
+	if !errors.Is(err, strconv.ErrSyntax) {
+		t.Errorf("want: %v, got: %v", strconv.ErrSyntax, err)
+	}
+}
+
+func TestCommaInts(t *testing.T) {
+	got, err := CommaInts(write(t, "3,4, 5\n6\n"))
+	if want := []int{3, 4, 5, 6}; err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("want: %v, got: %v, %v", want, got, err)
+	}
+
+	filename := write(t, "1,2\n3,,4\n")
+	_, err = CommaInts(filename)
+	checkError(t, err, filename, 2)
+}
+
+func TestSections(t *testing.T) {
+	got, err := Sections(write(t, "3-5\n10-14\n\n1\n5\n"))
+	if want := [][]string{{"3-5", "10-14"}, {"1", "5"}}; err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("want: %q, got: %q, %v", want, got, err)
+	}
+}
+
+func TestGrid(t *testing.T) {
+	got, err := Grid(write(t, "..@\n@@.\n"))
+	if want := [][]byte{[]byte("..@"), []byte("@@.")}; err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("want: %q, got: %q, %v", want, got, err)
+	}
+
+	filename := write(t, "..@\n@@.\n@\n")
+	_, err = Grid(filename)
+	checkError(t, err, filename, 3)
+}
+
+type point struct {
+	X, Y, Z int
+	hidden  bool
+}
+
+func TestScan(t *testing.T) {
+	points, err := Scan[point](write(t, "162,817,812\n57,618,57\n"), "%d,%d,%d")
+	if want := []point{{162, 817, 812, false}, {57, 618, 57, false}}; err != nil || !reflect.DeepEqual(points, want) {
+		t.Errorf("want: %v, got: %v, %v", want, points, err)
+	}
+
+	ints, err := Scan[int](write(t, "#1\n#22\n"), "#%d")
+	if want := []int{1, 22}; err != nil || !reflect.DeepEqual(ints, want) {
+		t.Errorf("want: %v, got: %v, %v", want, ints, err)
+	}
+
+	for _, content := range []string{
+		"1,2,3\n4,5\n",
+		"1,2,3\n4,5,6x\n",
+		"1,2,3\n4;5;6\n",
+	} {
+		filename := write(t, content)
+		_, err := Scan[point](filename, "%d,%d,%d")
+		checkError(t, err, filename, 2)
+	}
+}
@@ -1,96 +1,227 @@
 package interval
 
 import (
-	"bufio"
-	"bytes"
 	"fmt"
-	"io"
-	"log"
-	"strconv"
+	"iter"
 )
 
-// Read an interval from the reader
-//
-// Starting at the current position of the reader, read bytes until it found
-// delim, once there it will separate the buffer in two parts using the
-// separator
-//
-// For example, with this data available in the reader: "123,456=789,abc"
-//
-// This call:
-//
-//	interval.Read(r, '=', []byte{','})
-//
-// # Will return an interval with values 123 and 456
+// Integer is any integer type, signed or unsigned
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// Kind tells whether the high bound of an interval is part of it or not
+type Kind byte
+
+const (
+	// Closed intervals contain both bounds: [Low, High]
+	Closed Kind = iota
+	// HalfOpen intervals contain the low bound but not the high one: [Low, High)
+	HalfOpen
+)
+
+// Interval is a range of consecutive integers between Low and High
 //
-// If the end of file (EOF) is reached then the interval and true are returned
-func Read(r *bufio.Reader, delim byte, separator []byte) (Interval, bool) {
-	data, err := r.ReadBytes(delim)
-	isEOF := err == io.EOF
-	if !isEOF && err != nil {
-		log.Fatalf("can't read range: %v", err)
+// The zero value is the closed interval [0, 0]. An interval with no numbers
+// in it (Low > High when closed, Low >= High when half-open) is empty
+type Interval[T Integer] struct {
+	Low, High T
+	Kind      Kind
+}
+
+// New returns the closed interval [low, high]
+func New[T Integer](low, high T) Interval[T] {
+	return Interval[T]{Low: low, High: high, Kind: Closed}
+}
+
+// NewHalfOpen returns the half-open interval [low, high)
+func NewHalfOpen[T Integer](low, high T) Interval[T] {
+	return Interval[T]{Low: low, High: high, Kind: HalfOpen}
+}
+
+// empty returns an empty interval of the given kind that is safe from
+// overflows on unsigned types
+func empty[T Integer](kind Kind) Interval[T] {
+	if kind == HalfOpen {
+		return NewHalfOpen[T](0, 0)
+	}
+	return New[T](1, 0)
+}
+
+func (i Interval[T]) String() string {
+	if i.Kind == HalfOpen {
+		return fmt.Sprintf("[%d, %d)", i.Low, i.High)
+	}
+	return fmt.Sprintf("[%d, %d]", i.Low, i.High)
+}
+
+func (i Interval[T]) IsEmpty() bool {
+	if i.Kind == HalfOpen {
+		return i.Low >= i.High
+	}
+	return i.Low > i.High
+}
+
+// First returns the smallest number in the interval, which must not be empty
+func (i Interval[T]) First() T {
+	return i.Low
+}
+
+// Last returns the biggest number in the interval, which must not be empty
+func (i Interval[T]) Last() T {
+	if i.Kind == HalfOpen {
+		return i.High - 1
 	}
+	return i.High
+}
+
+// Closed returns the same numbers as a closed interval
+func (i Interval[T]) Closed() Interval[T] {
+	if i.IsEmpty() {
+		return empty[T](Closed)
+	}
+	return New(i.First(), i.Last())
+}
 
-	// remove delim from data
-	data = data[:len(data)-1]
-	parts := bytes.Split(data, separator)
+// HalfOpen returns the same numbers as a half-open interval
+//
+// The interval must not contain the maximum value of T
+func (i Interval[T]) HalfOpen() Interval[T] {
+	if i.IsEmpty() {
+		return empty[T](HalfOpen)
+	}
+	return NewHalfOpen(i.First(), i.Last()+1)
+}
 
-	low, err := strconv.Atoi(string(parts[0]))
-	if err != nil {
-		log.Fatalf("can't parse range low value: %v", err)
+// withBounds returns an interval of the same kind as i with first and last
+// as its smallest and biggest numbers
+func (i Interval[T]) withBounds(first, last T) Interval[T] {
+	if first > last {
+		return empty[T](i.Kind)
 	}
-	high, err := strconv.Atoi(string(parts[1]))
-	if err != nil {
-		log.Fatalf("can't parse range high value: %v", err)
+	if i.Kind == HalfOpen {
+		return NewHalfOpen(first, last+1)
 	}
+	return New(first, last)
+}
 
-	return Interval{
-		low:  low,
-		high: high,
-	}, isEOF
+func (i Interval[T]) Contains(n T) bool {
+	if i.Kind == HalfOpen {
+		return i.Low <= n && n < i.High
+	}
+	return i.Low <= n && n <= i.High
 }
 
-type Interval struct {
-	low, high int
+// Equals reports whether both intervals contain the same numbers, regardless
+// of their kind
+func (i Interval[T]) Equals(o Interval[T]) bool {
+	if i.IsEmpty() || o.IsEmpty() {
+		return i.IsEmpty() && o.IsEmpty()
+	}
+	return i.First() == o.First() && i.Last() == o.Last()
 }
 
-func (i Interval) String() string {
-	return fmt.Sprintf("(%d, %d)", i.low, i.high)
+// IsInside reports whether every number of i is also in o
+func (i Interval[T]) IsInside(o Interval[T]) bool {
+	if i.IsEmpty() {
+		return true
+	}
+	if o.IsEmpty() {
+		return false
+	}
+	return i.First() >= o.First() && i.Last() <= o.Last()
 }
 
-func (i Interval) Contains(n int) bool {
-	return i.low <= n && n <= i.high
+// Overlaps reports whether both intervals have at least one number in common
+func (i Interval[T]) Overlaps(o Interval[T]) bool {
+	if i.IsEmpty() || o.IsEmpty() {
+		return false
+	}
+	return i.First() <= o.Last() && o.First() <= i.Last()
 }
 
-func (i Interval) Equals(o Interval) bool {
-	return i.low == o.low && i.high == o.high
+// Adjacent reports whether both intervals don't overlap but there is no
+// number between them, like [1, 3] and [4, 6]
+func (i Interval[T]) Adjacent(o Interval[T]) bool {
+	if i.IsEmpty() || o.IsEmpty() {
+		return false
+	}
+	if i.Last() < o.First() {
+		return i.Last()+1 == o.First()
+	}
+	if o.Last() < i.First() {
+		return o.Last()+1 == i.First()
+	}
+	return false
 }
 
-func (i Interval) IsInside(o Interval) bool {
-	return i.low >= o.low && i.high <= o.high
+// Merge returns the smallest interval, of the same kind as i, that contains
+// both intervals
+func (i Interval[T]) Merge(o Interval[T]) Interval[T] {
+	if i.IsEmpty() {
+		return o
+	}
+	if o.IsEmpty() {
+		return i
+	}
+	return i.withBounds(min(i.First(), o.First()), max(i.Last(), o.Last()))
 }
 
-func (i Interval) Overlaps(o Interval) bool {
-	return i.Contains(o.low) || i.Contains(o.high)
+// Intersection returns the numbers in both intervals, as an interval of the
+// same kind as i, and whether there is any
+func (i Interval[T]) Intersection(o Interval[T]) (Interval[T], bool) {
+	if !i.Overlaps(o) {
+		return empty[T](i.Kind), false
+	}
+	return i.withBounds(max(i.First(), o.First()), min(i.Last(), o.Last())), true
 }
 
-func (i Interval) Merge(o Interval) Interval {
-	return Interval{
-		low:  min(i.low, o.low),
-		high: max(i.high, o.high),
+// Split the interval in two: the numbers lower than at, and the rest
+//
+// Any of the intervals returned can be empty
+func (i Interval[T]) Split(at T) (Interval[T], Interval[T]) {
+	if i.IsEmpty() {
+		return i, i
+	}
+	if at <= i.First() {
+		return empty[T](i.Kind), i
 	}
+	if at > i.Last() {
+		return i, empty[T](i.Kind)
+	}
+	return i.withBounds(i.First(), at-1), i.withBounds(at, i.Last())
+}
+
+// Shift moves both bounds of the interval by n
+func (i Interval[T]) Shift(n T) Interval[T] {
+	i.Low += n
+	i.High += n
+	return i
 }
 
-func (i Interval) Distance() int {
-	return i.high - i.low + 1
+// Clamp returns the number of the interval closest to n, the interval must
+// not be empty
+func (i Interval[T]) Clamp(n T) T {
+	return min(max(n, i.First()), i.Last())
 }
 
-type RangeYield func(int) bool
+// Distance returns the amount of numbers in the interval
+func (i Interval[T]) Distance() T {
+	if i.IsEmpty() {
+		return 0
+	}
+	return i.Last() - i.First() + 1
+}
 
-func Range(r Interval) func(RangeYield) {
-	return func(yield RangeYield) {
-		for n := r.low; n <= r.high; n++ {
-			if !yield(n) {
+// Range iterates over every number of the interval in increasing order
+func Range[T Integer](r Interval[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if r.IsEmpty() {
+			return
+		}
+		for n := r.First(); ; n++ {
+			if !yield(n) || n == r.Last() {
 				return
 			}
 		}
@@ -0,0 +1,179 @@
+package interval
+
+import (
+	"testing"
+	"testing/quick"
+)
+
+// members returns, by brute force, which numbers of T are in the interval
+func members[T int8 | uint8](i Interval[T]) map[T]bool {
+	m := map[T]bool{}
+	for n := range 256 {
+		v := T(n)
+		if i.Kind == HalfOpen && i.Low <= v && v < i.High {
+			m[v] = true
+		}
+		if i.Kind == Closed && i.Low <= v && v <= i.High {
+			m[v] = true
+		}
+	}
+	return m
+}
+
+func kindOf(halfOpen bool) Kind {
+	if halfOpen {
+		return HalfOpen
+	}
+	return Closed
+}
+
+func sameMembers[T int8 | uint8](i Interval[T], want map[T]bool) bool {
+	got := members(i)
+	if len(got) != len(want) {
+		return false
+	}
+	for n := range want {
+		if !got[n] {
+			return false
+		}
+	}
+	return true
+}
+
+func checkProperties[T int8 | uint8](t *testing.T) {
+	newInterval := func(low, high T, halfOpen bool) Interval[T] {
+		return Interval[T]{Low: low, High: high, Kind: kindOf(halfOpen)}
+	}
+
+	properties := map[string]any{
+		"Contains": func(low, high, n T, halfOpen bool) bool {
+			i := newInterval(low, high, halfOpen)
+			return i.Contains(n) == members(i)[n]
+		},
+		"IsEmpty and Distance": func(low, high T, halfOpen bool) bool {
+			i := newInterval(low, high, halfOpen)
+			m := members(i)
+			return i.IsEmpty() == (len(m) == 0) && i.Distance() == T(len(m))
+		},
+		"Closed and HalfOpen keep the numbers": func(low, high T, halfOpen bool) bool {
+			i := newInterval(low, high, halfOpen)
+			m := members(i)
+			if !sameMembers(i.Closed(), m) {
+				return false
+			}
+			if !i.IsEmpty() && i.Last()+1 < i.Last() {
+				// T can't represent the high bound of a half-open interval
+				return true
+			}
+			return sameMembers(i.HalfOpen(), m)
+		},
+		"Overlaps": func(a, b, c, d T, ah, bh bool) bool {
+			i, o := newInterval(a, b, ah), newInterval(c, d, bh)
+			mi, mo := members(i), members(o)
+			want := false
+			for n := range mi {
+				want = want || mo[n]
+			}
+			return i.Overlaps(o) == want && o.Overlaps(i) == want
+		},
+		"Adjacent": func(a, b, c, d T, ah, bh bool) bool {
+			i, o := newInterval(a, b, ah), newInterval(c, d, bh)
+			mi, mo := members(i), members(o)
+			want := len(mi) > 0 && len(mo) > 0 && !i.Overlaps(o)
+			if want {
+				// the union has no gaps
+				union := newInterval(min(i.First(), o.First()), max(i.Last(), o.Last()), false)
+				want = len(members(union)) == len(mi)+len(mo)
+			}
+			return i.Adjacent(o) == want && o.Adjacent(i) == want
+		},
+		"Intersection": func(a, b, c, d T, ah, bh bool) bool {
+			i, o := newInterval(a, b, ah), newInterval(c, d, bh)
+			mi, mo := members(i), members(o)
+			want := map[T]bool{}
+			for n := range mi {
+				if mo[n] {
+					want[n] = true
+				}
+			}
+			got, ok := i.Closed().Intersection(o)
+			return ok == (len(want) > 0) && sameMembers(got, want)
+		},
+		"IsInside and Equals": func(a, b, c, d T, ah, bh bool) bool {
+			i, o := newInterval(a, b, ah), newInterval(c, d, bh)
+			mi, mo := members(i), members(o)
+			inside := true
+			for n := range mi {
+				inside = inside && mo[n]
+			}
+			equals := inside && len(mi) == len(mo)
+			return i.IsInside(o) == inside && i.Equals(o) == equals
+		},
+		"Merge contains both": func(a, b, c, d T, ah, bh bool) bool {
+			i, o := newInterval(a, b, ah).Closed(), newInterval(c, d, bh)
+			m := i.Merge(o)
+			return i.IsInside(m) && o.IsInside(m)
+		},
+		"Split": func(low, high, at T, halfOpen bool) bool {
+			i := newInterval(low, high, halfOpen).Closed()
+			left, right := i.Split(at)
+			for n := range members(i) {
+				if left.Contains(n) != (n < at) || right.Contains(n) != (n >= at) {
+					return false
+				}
+			}
+			return left.Distance()+right.Distance() == i.Distance()
+		},
+		"Clamp": func(low, high, n T, halfOpen bool) bool {
+			i := newInterval(low, high, halfOpen)
+			if i.IsEmpty() {
+				return true
+			}
+			c := i.Clamp(n)
+			if i.Contains(n) {
+				return c == n
+			}
+			return i.Contains(c) && (c == i.First() || c == i.Last())
+		},
+		"Range": func(low, high T, halfOpen bool) bool {
+			i := newInterval(low, high, halfOpen)
+			got := map[T]bool{}
+			for n := range Range(i) {
+				got[n] = true
+			}
+			return sameMembers(i, got)
+		},
+	}
+
+	for name, property := range properties {
+		t.Run(name, func(t *testing.T) {
+			if err := quick.Check(property, &quick.Config{MaxCount: 2000}); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestPropertiesSigned(t *testing.T) {
+	checkProperties[int8](t)
+}
+
+func TestPropertiesUnsigned(t *testing.T) {
+	checkProperties[uint8](t)
+}
+
+func TestShift(t *testing.T) {
+	i := NewHalfOpen[int64](10, 20).Shift(-5)
+	if want := NewHalfOpen[int64](5, 15); i != want {
+		t.Errorf("Shift(-5) got %s; want: %s", i, want)
+	}
+}
+
+func TestString(t *testing.T) {
+	if got, want := New(3, 5).String(), "[3, 5]"; got != want {
+		t.Errorf("got %s; want: %s", got, want)
+	}
+	if got, want := NewHalfOpen(3, 5).String(), "[3, 5)"; got != want {
+		t.Errorf("got %s; want: %s", got, want)
+	}
+}
@@ -0,0 +1,253 @@
+package interval
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+)
+
+// SyntaxError is returned when the input of a Scanner is not a valid list of
+// intervals
+type SyntaxError struct {
+	Offset int64 // offset, in bytes, where the error was found
+	Msg    string
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("offset %d: %s", e.Offset, e.Msg)
+}
+
+// Scanner reads closed intervals, like "123-456" or "123..456", separated by
+// delim
+//
+// Spaces, tabs and carriage returns around numbers, separators and delimiters
+// are ignored, so are new lines when delim is not '\n'. The last interval
+// doesn't need a delimiter after it.
+//
+// When delim is '\n' a blank line ends the scan without error and leaves the
+// reader right after it, so the rest of the input can be read from the same
+// *bufio.Reader. With any other delim an empty record, like ",,", is an error
+//
+// For example, to read all the intervals of "11-22,95-115,998-1012":
+//
+//	s := interval.NewScanner[int](r, ',')
+//	for s.Scan() {
+//		fmt.Println(s.Interval())
+//	}
+//	if err := s.Err(); err != nil {
+//		log.Fatal(err)
+//	}
+type Scanner[T Integer] struct {
+	r        *bufio.Reader
+	delim    byte
+	offset   int64
+	interval Interval[T]
+	err      error
+	done     bool
+}
+
+// NewScanner returns a scanner that reads from r, if r is already a
+// *bufio.Reader the scanner will read directly from it
+func NewScanner[T Integer](r io.Reader, delim byte) *Scanner[T] {
+	return &Scanner[T]{
+		r:     bufio.NewReader(r),
+		delim: delim,
+	}
+}
+
+// All iterates over every interval in r separated by delim, if there is an
+// error it is yielded as the last element
+func All[T Integer](r io.Reader, delim byte) iter.Seq2[Interval[T], error] {
+	return func(yield func(Interval[T], error) bool) {
+		s := NewScanner[T](r, delim)
+		for s.Scan() {
+			if !yield(s.Interval(), nil) {
+				return
+			}
+		}
+		if err := s.Err(); err != nil {
+			yield(Interval[T]{}, err)
+		}
+	}
+}
+
+// Interval returns the last interval read by Scan
+func (s *Scanner[T]) Interval() Interval[T] {
+	return s.interval
+}
+
+// Err returns the first error found by Scan, if any
+func (s *Scanner[T]) Err() error {
+	return s.err
+}
+
+// Offset returns the amount of bytes read so far
+func (s *Scanner[T]) Offset() int64 {
+	return s.offset
+}
+
+// Scan reads the next interval, it returns false when there are no more
+// intervals to read or an error was found
+func (s *Scanner[T]) Scan() bool {
+	if s.done {
+		return false
+	}
+
+	b, ok := s.peekSkippingSpaces()
+	if !ok {
+		return s.stop()
+	}
+	if b == s.delim {
+		if s.delim != '\n' {
+			return s.fail("empty interval")
+		}
+		// blank line: end of this list of intervals
+		s.readByte()
+		return s.stop()
+	}
+
+	low, ok := s.readNumber()
+	if !ok {
+		return false
+	}
+	if !s.readSeparator() {
+		return false
+	}
+	high, ok := s.readNumber()
+	if !ok {
+		return false
+	}
+
+	b, ok = s.peekSkippingSpaces()
+	switch {
+	case !ok:
+		if s.err != nil {
+			return false
+		}
+		s.done = true
+	case b == s.delim:
+		s.readByte()
+	default:
+		return s.fail(fmt.Sprintf("expected %q or end of input after interval, got %q", s.delim, b))
+	}
+
+	s.interval = New(low, high)
+	return true
+}
+
+func (s *Scanner[T]) stop() bool {
+	s.done = true
+	return false
+}
+
+func (s *Scanner[T]) fail(msg string) bool {
+	s.done = true
+	s.err = &SyntaxError{Offset: s.offset, Msg: msg}
+	return false
+}
+
+func (s *Scanner[T]) readByte() (byte, bool) {
+	b, err := s.r.ReadByte()
+	if err != nil {
+		if !errors.Is(err, io.EOF) {
+			s.done = true
+			s.err = err
+		}
+		return 0, false
+	}
+	s.offset++
+	return b, true
+}
+
+func (s *Scanner[T]) unreadByte() {
+	s.r.UnreadByte()
+	s.offset--
+}
+
+func (s *Scanner[T]) isSpace(b byte) bool {
+	if b == s.delim {
+		return false
+	}
+	return b == ' ' || b == '\t' || b == '\r' || b == '\n'
+}
+
+// peekSkippingSpaces discards every space and returns the next byte without
+// consuming it, or false at the end of the input
+func (s *Scanner[T]) peekSkippingSpaces() (byte, bool) {
+	for {
+		b, ok := s.readByte()
+		if !ok {
+			return 0, false
+		}
+		if !s.isSpace(b) {
+			s.unreadByte()
+			return b, true
+		}
+	}
+}
+
+func (s *Scanner[T]) readNumber() (T, bool) {
+	var n T
+
+	b, ok := s.peekSkippingSpaces()
+	if !ok {
+		if s.err == nil {
+			s.fail("expected a number, got end of input")
+		}
+		return n, false
+	}
+	if b < '0' || b > '9' {
+		return n, s.fail(fmt.Sprintf("expected a number, got %q", b))
+	}
+
+	for {
+		b, ok := s.readByte()
+		if !ok {
+			return n, s.err == nil
+		}
+		if b < '0' || b > '9' {
+			s.unreadByte()
+			return n, true
+		}
+
+		m := n * 10
+		if m/10 != n {
+			return n, s.fail("number out of range")
+		}
+		next := m + T(b-'0')
+		if next < m {
+			return n, s.fail("number out of range")
+		}
+		n = next
+	}
+}
+
+// readSeparator reads a "-" or a ".."
+func (s *Scanner[T]) readSeparator() bool {
+	b, ok := s.peekSkippingSpaces()
+	if !ok {
+		if s.err == nil {
+			s.fail(`expected "-" or "..", got end of input`)
+		}
+		return false
+	}
+	s.readByte()
+
+	switch b {
+	case '-':
+		return true
+	case '.':
+		if b, ok := s.readByte(); ok && b == '.' {
+			return true
+		}
+		if s.err != nil {
+			return false
+		}
+		return s.fail(`expected ".." as separator`)
+	}
+
+	s.unreadByte()
+	return s.fail(fmt.Sprintf(`expected "-" or "..", got %q`, b))
+}
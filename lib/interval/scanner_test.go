@@ -0,0 +1,180 @@
+package interval
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestScanner(t *testing.T) {
+	for _, tc := range []struct {
+		input   string
+		delim   byte
+		want    []Interval[int]
+		wantErr int64 // offset of the error, -1 if there is no error
+	}{
+		{input: "", delim: ',', want: nil, wantErr: -1},
+		{input: "11-22,95-115", delim: ',', want: []Interval[int]{New(11, 22), New(95, 115)}, wantErr: -1},
+		{input: "11-22,95-115,", delim: ',', want: []Interval[int]{New(11, 22), New(95, 115)}, wantErr: -1},
+		{input: "11-22,95-115\n", delim: ',', want: []Interval[int]{New(11, 22), New(95, 115)}, wantErr: -1},
+		{input: " 11 - 22 ,\n95..115 ", delim: ',', want: []Interval[int]{New(11, 22), New(95, 115)}, wantErr: -1},
+		{input: "3-5\r\n10-14\n", delim: '\n', want: []Interval[int]{New(3, 5), New(10, 14)}, wantErr: -1},
+		{input: "3-5\n10-14\n\n1\n5\n", delim: '\n', want: []Interval[int]{New(3, 5), New(10, 14)}, wantErr: -1},
+		{input: "2121212118-2121212124", delim: ',', want: []Interval[int]{New(2121212118, 2121212124)}, wantErr: -1},
+		{input: "11-22,x-1", delim: ',', want: []Interval[int]{New(11, 22)}, wantErr: 6},
+		{input: "11+22", delim: ',', wantErr: 2},
+		{input: "11.22", delim: ',', wantErr: 4},
+		{input: "11-", delim: ',', wantErr: 3},
+		{input: "11-22 33", delim: ',', wantErr: 6},
+		{input: "1-99999999999999999999", delim: ',', wantErr: 21},
+		{input: "11-22,,33-44", delim: ',', want: []Interval[int]{New(11, 22)}, wantErr: 6},
+		{input: ",11-22", delim: ',', wantErr: 0},
+	} {
+		t.Run(fmt.Sprintf("Scan(%q)", tc.input), func(t *testing.T) {
+			s := NewScanner[int](strings.NewReader(tc.input), tc.delim)
+			var got []Interval[int]
+			for s.Scan() {
+				got = append(got, s.Interval())
+			}
+
+			if !slices.Equal(got, tc.want) {
+				t.Errorf("want: %v, got: %v", tc.want, got)
+			}
+
+			var syntaxErr *SyntaxError
+			switch err := s.Err(); {
+			case tc.wantErr < 0 && err != nil:
+				t.Errorf("unexpected error: %v", err)
+			case tc.wantErr >= 0 && !errors.As(err, &syntaxErr):
+				t.Errorf("want a syntax error, got: %v", err)
+			case tc.wantErr >= 0 && syntaxErr.Offset != tc.wantErr:
+				t.Errorf("want error at offset %d, got: %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestScannerLeavesTheRestOfTheInput(t *testing.T) {
+	r := bufio.NewReader(strings.NewReader("3-5\n10-14\n\n1\n5\n"))
+	s := NewScanner[int](r, '\n')
+	for s.Scan() {
+	}
+
+	rest, _ := io.ReadAll(r)
+	if got, want := string(rest), "1\n5\n"; got != want {
+		t.Errorf("want: %q, got: %q", want, got)
+	}
+}
+
+func TestAll(t *testing.T) {
+	var got []Interval[uint64]
+	var gotErr error
+	for i, err := range All[uint64](strings.NewReader("1-2,3..18446744073709551615,4"), ',') {
+		if err != nil {
+			gotErr = err
+			continue
+		}
+		got = append(got, i)
+	}
+
+	want := []Interval[uint64]{New[uint64](1, 2), New[uint64](3, 18446744073709551615)}
+	if !slices.Equal(got, want) {
+		t.Errorf("want: %v, got: %v", want, got)
+	}
+	if gotErr == nil {
+		t.Errorf("want an error for the last interval")
+	}
+}
+
+func TestScannerDoesNotAllocate(t *testing.T) {
+	input := strings.Repeat("11-22, 95..115,", 100)
+	r := strings.NewReader(input)
+	br := bufio.NewReader(r)
+	s := NewScanner[int](br, ',')
+
+	allocs := testing.AllocsPerRun(10, func() {
+		r.Reset(input)
+		br.Reset(r)
+		s.done = false
+		for s.Scan() {
+		}
+	})
+	if allocs != 0 {
+		t.Errorf("want no allocations, got: %v", allocs)
+	}
+}
+
+func FuzzScanner(f *testing.F) {
+	for _, seed := range []struct {
+		filename string
+		delim    byte
+	}{
+		{"../../years/y2025/input/day2.test", ','},
+		{"../../years/y2025/input/day5.test", '\n'},
+	} {
+		data, err := os.ReadFile(seed.filename)
+		if err != nil {
+			f.Fatalf("can't read seeds from %q: %v", seed.filename, err)
+		}
+		f.Add(string(data), seed.delim)
+	}
+	f.Add("11-22,,33-44", byte(','))
+
+	f.Fuzz(func(t *testing.T, input string, delim byte) {
+		var intervals []Interval[int16]
+		s := NewScanner[int16](strings.NewReader(input), delim)
+		for s.Scan() {
+			intervals = append(intervals, s.Interval())
+		}
+		if s.Offset() < 0 || s.Offset() > int64(len(input)) {
+			t.Fatalf("Offset() got %d; want between 0 and %d", s.Offset(), len(input))
+		}
+		if delim >= '0' && delim <= '9' || delim == '-' || delim == '.' {
+			// the delimiter is part of the intervals, they can't be written back
+			return
+		}
+		if delim != '\n' && s.Err() == nil && hasEmptyRecord(input, delim) {
+			t.Fatalf("want an error for the empty interval in %q, got none", input)
+		}
+
+		// writing back what was read reads the same intervals
+		var written []string
+		for _, i := range intervals {
+			written = append(written, fmt.Sprintf("%d-%d", i.Low, i.High))
+		}
+		var again []Interval[int16]
+		for i, err := range All[int16](strings.NewReader(strings.Join(written, string(delim))), delim) {
+			if err != nil {
+				t.Fatalf("can't read %q again: %v", written, err)
+			}
+			again = append(again, i)
+		}
+		if !slices.Equal(intervals, again) {
+			t.Errorf("read %v from %q, but %v after writing them back", intervals, input, again)
+		}
+	})
+}
+
+// hasEmptyRecord reports whether input has a delim with only spaces before
+// it, either another delim or the start of input
+func hasEmptyRecord(input string, delim byte) bool {
+	empty := true
+	for i := range len(input) {
+		switch b := input[i]; {
+		case b == delim:
+			if empty {
+				return true
+			}
+			empty = true
+		case b == ' ' || b == '\t' || b == '\r' || b == '\n':
+		default:
+			empty = false
+		}
+	}
+	return false
+}
@@ -0,0 +1,216 @@
+package interval
+
+import (
+	"cmp"
+	"iter"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// Set is a set of integers stored as sorted, disjoint and non adjacent
+// closed intervals
+//
+// Overlapping or adjacent intervals are merged as soon as they are added, so
+// [1, 3] and [4, 6] are stored as [1, 6]
+type Set[T Integer] struct {
+	intervals []Interval[T]
+}
+
+// NewSet returns a set with the union of the given intervals
+func NewSet[T Integer](intervals ...Interval[T]) *Set[T] {
+	sorted := make([]Interval[T], 0, len(intervals))
+	for _, i := range intervals {
+		if !i.IsEmpty() {
+			sorted = append(sorted, i.Closed())
+		}
+	}
+	slices.SortFunc(sorted, func(a, b Interval[T]) int {
+		return cmp.Compare(a.Low, b.Low)
+	})
+	return &Set[T]{intervals: normalize(sorted)}
+}
+
+func (s *Set[T]) Len() int {
+	return len(s.intervals)
+}
+
+func (s *Set[T]) IsEmpty() bool {
+	return len(s.intervals) == 0
+}
+
+// All iterates over the intervals of the set in increasing order
+func (s *Set[T]) All() iter.Seq[Interval[T]] {
+	return slices.Values(s.intervals)
+}
+
+func (s *Set[T]) Slice() []Interval[T] {
+	return slices.Clone(s.intervals)
+}
+
+func (s *Set[T]) Clone() *Set[T] {
+	return &Set[T]{intervals: slices.Clone(s.intervals)}
+}
+
+// Add every number of the interval to the set
+func (s *Set[T]) Add(i Interval[T]) {
+	if i.IsEmpty() {
+		return
+	}
+	i = i.Closed()
+
+	// first interval that ends at, or right before, the start of i
+	from := sort.Search(len(s.intervals), func(n int) bool {
+		return touches(s.intervals[n].High, i.Low)
+	})
+	// first interval that starts after the end of i, without touching it
+	to := sort.Search(len(s.intervals), func(n int) bool {
+		return !touches(i.High, s.intervals[n].Low)
+	})
+
+	if from < to {
+		i.Low = min(i.Low, s.intervals[from].Low)
+		i.High = max(i.High, s.intervals[to-1].High)
+	}
+	s.intervals = slices.Replace(s.intervals, from, to, i)
+}
+
+// Remove every number of the interval from the set
+func (s *Set[T]) Remove(i Interval[T]) {
+	if i.IsEmpty() {
+		return
+	}
+	i = i.Closed()
+
+	from := sort.Search(len(s.intervals), func(n int) bool {
+		return s.intervals[n].High >= i.Low
+	})
+	to := sort.Search(len(s.intervals), func(n int) bool {
+		return s.intervals[n].Low > i.High
+	})
+	if from >= to {
+		return
+	}
+
+	var keep []Interval[T]
+	if first := s.intervals[from]; first.Low < i.Low {
+		keep = append(keep, New(first.Low, i.Low-1))
+	}
+	if last := s.intervals[to-1]; last.High > i.High {
+		keep = append(keep, New(i.High+1, last.High))
+	}
+	s.intervals = slices.Replace(s.intervals, from, to, keep...)
+}
+
+// Contains reports whether n is in any interval of the set
+func (s *Set[T]) Contains(n T) bool {
+	i := sort.Search(len(s.intervals), func(i int) bool {
+		return s.intervals[i].High >= n
+	})
+	return i < len(s.intervals) && s.intervals[i].Low <= n
+}
+
+// TotalLength returns the amount of numbers in the set
+func (s *Set[T]) TotalLength() T {
+	var total T
+	for _, i := range s.intervals {
+		total += i.Distance()
+	}
+	return total
+}
+
+// Union returns a new set with the numbers that are in s or in o
+func (s *Set[T]) Union(o *Set[T]) *Set[T] {
+	merged := make([]Interval[T], 0, len(s.intervals)+len(o.intervals))
+	a, b := 0, 0
+	for a < len(s.intervals) || b < len(o.intervals) {
+		if b == len(o.intervals) || (a < len(s.intervals) && s.intervals[a].Low <= o.intervals[b].Low) {
+			merged = append(merged, s.intervals[a])
+			a++
+		} else {
+			merged = append(merged, o.intervals[b])
+			b++
+		}
+	}
+	return &Set[T]{intervals: normalize(merged)}
+}
+
+// Intersect returns a new set with the numbers that are in both s and o
+func (s *Set[T]) Intersect(o *Set[T]) *Set[T] {
+	var result []Interval[T]
+	a, b := 0, 0
+	for a < len(s.intervals) && b < len(o.intervals) {
+		x, y := s.intervals[a], o.intervals[b]
+		if i, ok := x.Intersection(y); ok {
+			result = append(result, i)
+		}
+		if x.High < y.High {
+			a++
+		} else {
+			b++
+		}
+	}
+	return &Set[T]{intervals: result}
+}
+
+// Subtract returns a new set with the numbers that are in s but not in o
+func (s *Set[T]) Subtract(o *Set[T]) *Set[T] {
+	var result []Interval[T]
+	b := 0
+	for _, x := range s.intervals {
+		// skip the intervals of o that end before x
+		for b < len(o.intervals) && o.intervals[b].High < x.Low {
+			b++
+		}
+
+		low, done := x.Low, false
+		for n := b; !done && n < len(o.intervals) && o.intervals[n].Low <= x.High; n++ {
+			y := o.intervals[n]
+			if y.Low > low {
+				result = append(result, New(low, y.Low-1))
+			}
+			if y.High >= x.High {
+				done = true
+			} else {
+				low = max(low, y.High+1)
+			}
+		}
+		if !done {
+			result = append(result, New(low, x.High))
+		}
+	}
+	return &Set[T]{intervals: result}
+}
+
+func (s *Set[T]) String() string {
+	var buf strings.Builder
+	buf.WriteString("{")
+	for n, i := range s.intervals {
+		if n != 0 {
+			buf.WriteString(", ")
+		}
+		buf.WriteString(i.String())
+	}
+	buf.WriteString("}")
+	return buf.String()
+}
+
+// touches reports whether a number lower than or equal to high and a number
+// greater than or equal to low have nothing in between, without overflowing
+func touches[T Integer](high, low T) bool {
+	return high >= low || high+1 == low
+}
+
+// normalize merges overlapping and adjacent closed intervals of a slice sorted
+// by their low value
+func normalize[T Integer](sorted []Interval[T]) []Interval[T] {
+	result := sorted[:0]
+	for _, i := range sorted {
+		if n := len(result) - 1; n >= 0 && touches(result[n].High, i.Low) {
+			result[n].High = max(result[n].High, i.High)
+			continue
+		}
+		result = append(result, i)
+	}
+	return result
+}
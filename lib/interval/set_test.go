@@ -0,0 +1,128 @@
+package interval
+
+import (
+	"math/rand/v2"
+	"testing"
+)
+
+// model is a brute force set of the numbers in [0, modelSize)
+type model [modelSize]bool
+
+const modelSize = 64
+
+func randomIntervals(r *rand.Rand, n int) []Interval[int] {
+	intervals := make([]Interval[int], n)
+	for i := range intervals {
+		low := r.IntN(modelSize)
+		high := low + r.IntN(10) - 1 // allow empty intervals
+		intervals[i] = New(low, min(high, modelSize-1))
+	}
+	return intervals
+}
+
+func modelOf(intervals ...Interval[int]) (m model) {
+	for _, i := range intervals {
+		for n := i.Low; n <= i.High; n++ {
+			m[n] = true
+		}
+	}
+	return m
+}
+
+func checkSet(t *testing.T, s *Set[int], want model) {
+	t.Helper()
+
+	total := 0
+	for n, in := range want {
+		if in {
+			total++
+		}
+		if got := s.Contains(n); got != in {
+			t.Fatalf("%s Contains(%d) got %v; want: %v", s, n, got, in)
+		}
+	}
+	if got := s.TotalLength(); got != total {
+		t.Fatalf("%s TotalLength() got %d; want: %d", s, got, total)
+	}
+
+	last := New(-10, -10)
+	for i := range s.All() {
+		if i.IsEmpty() {
+			t.Fatalf("%s has an empty interval %s", s, i)
+		}
+		if i.Low <= last.High+1 {
+			t.Fatalf("%s is not sorted, disjoint and non adjacent", s)
+		}
+		last = i
+	}
+}
+
+func TestSetAgainstModel(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+
+	for range 500 {
+		as := randomIntervals(r, r.IntN(8))
+		bs := randomIntervals(r, r.IntN(8))
+		ma, mb := modelOf(as...), modelOf(bs...)
+
+		a, b := NewSet(as...), NewSet(bs...)
+		checkSet(t, a, ma)
+
+		var union, intersection, difference model
+		for n := range modelSize {
+			union[n] = ma[n] || mb[n]
+			intersection[n] = ma[n] && mb[n]
+			difference[n] = ma[n] && !mb[n]
+		}
+		checkSet(t, a.Union(b), union)
+		checkSet(t, a.Intersect(b), intersection)
+		checkSet(t, a.Subtract(b), difference)
+
+		added := a.Clone()
+		removed := a.Clone()
+		for _, i := range bs {
+			added.Add(i)
+			removed.Remove(i)
+		}
+		checkSet(t, added, union)
+		checkSet(t, removed, difference)
+	}
+}
+
+func TestSetMergesAdjacent(t *testing.T) {
+	s := NewSet(New(3, 5), New(10, 14), New(16, 20), New(12, 18))
+	if got, want := s.String(), "{[3, 5], [10, 20]}"; got != want {
+		t.Errorf("got %s; want: %s", got, want)
+	}
+	if got, want := s.TotalLength(), 14; got != want {
+		t.Errorf("TotalLength() got %d; want: %d", got, want)
+	}
+
+	s.Add(New(6, 9))
+	if got, want := s.String(), "{[3, 20]}"; got != want {
+		t.Errorf("got %s; want: %s", got, want)
+	}
+
+	s.Remove(New(5, 5))
+	if got, want := s.String(), "{[3, 4], [6, 20]}"; got != want {
+		t.Errorf("got %s; want: %s", got, want)
+	}
+}
+
+func TestSetUnsignedBounds(t *testing.T) {
+	s := NewSet(New[uint8](0, 3), NewHalfOpen[uint8](250, 255), New[uint8](255, 255))
+	if got, want := s.String(), "{[0, 3], [250, 255]}"; got != want {
+		t.Errorf("got %s; want: %s", got, want)
+	}
+
+	all := NewSet(New[uint8](0, 255))
+	if got, want := all.Subtract(s).String(), "{[4, 249]}"; got != want {
+		t.Errorf("Subtract got %s; want: %s", got, want)
+	}
+
+	s.Remove(New[uint8](0, 0))
+	s.Remove(New[uint8](255, 255))
+	if got, want := s.String(), "{[1, 3], [250, 254]}"; got != want {
+		t.Errorf("Remove got %s; want: %s", got, want)
+	}
+}
@@ -0,0 +1,174 @@
+package interval
+
+import (
+	"cmp"
+	"iter"
+	"slices"
+)
+
+// Tree is a static centered interval tree
+//
+// Unlike Set it keeps every interval as it was given, even if it overlaps
+// with others, and finds which of them contain a number or overlap another
+// interval in O(log n + k), k being the amount of intervals found
+type Tree[T Integer] struct {
+	root *treeNode[T]
+	size int
+}
+
+// treeNode holds every interval that contains center, the intervals that are
+// completely at the left of center go to the left node and the ones that are
+// completely at its right go to the right node
+type treeNode[T Integer] struct {
+	center      T
+	byFirst     []Interval[T] // sorted by increasing First
+	byLast      []Interval[T] // sorted by decreasing Last
+	left, right *treeNode[T]
+}
+
+// NewTree builds a tree with the given intervals, empty intervals are ignored
+func NewTree[T Integer](intervals []Interval[T]) *Tree[T] {
+	sorted := make([]Interval[T], 0, len(intervals))
+	for _, i := range intervals {
+		if !i.IsEmpty() {
+			sorted = append(sorted, i)
+		}
+	}
+	slices.SortStableFunc(sorted, func(a, b Interval[T]) int {
+		return cmp.Compare(a.First(), b.First())
+	})
+	return &Tree[T]{
+		root: buildTreeNode(sorted),
+		size: len(sorted),
+	}
+}
+
+// buildTreeNode builds the subtree of the intervals, sorted by First
+func buildTreeNode[T Integer](sorted []Interval[T]) *treeNode[T] {
+	if len(sorted) == 0 {
+		return nil
+	}
+
+	// the first number of the median interval is always contained by at least
+	// that interval, so no node is left empty
+	node := &treeNode[T]{center: sorted[len(sorted)/2].First()}
+
+	var left, right []Interval[T]
+	for _, i := range sorted {
+		switch {
+		case i.Last() < node.center:
+			left = append(left, i)
+		case i.First() > node.center:
+			right = append(right, i)
+		default:
+			node.byFirst = append(node.byFirst, i)
+		}
+	}
+
+	node.byLast = slices.Clone(node.byFirst)
+	slices.SortStableFunc(node.byLast, func(a, b Interval[T]) int {
+		return cmp.Compare(b.Last(), a.Last())
+	})
+
+	node.left = buildTreeNode(left)
+	node.right = buildTreeNode(right)
+	return node
+}
+
+func (t *Tree[T]) Len() int {
+	return t.size
+}
+
+// Contains reports whether any interval of the tree contains n
+func (t *Tree[T]) Contains(n T) bool {
+	for range t.Containing(n) {
+		return true
+	}
+	return false
+}
+
+// Containing iterates over every interval that contains n
+func (t *Tree[T]) Containing(n T) iter.Seq[Interval[T]] {
+	return func(yield func(Interval[T]) bool) {
+		for node := t.root; node != nil; {
+			switch {
+			case n < node.center:
+				for _, i := range node.byFirst {
+					if i.First() > n {
+						break
+					}
+					if !yield(i) {
+						return
+					}
+				}
+				node = node.left
+			case n > node.center:
+				for _, i := range node.byLast {
+					if i.Last() < n {
+						break
+					}
+					if !yield(i) {
+						return
+					}
+				}
+				node = node.right
+			default:
+				for _, i := range node.byFirst {
+					if !yield(i) {
+						return
+					}
+				}
+				return
+			}
+		}
+	}
+}
+
+// Overlapping iterates over every interval that has at least one number in
+// common with o
+func (t *Tree[T]) Overlapping(o Interval[T]) iter.Seq[Interval[T]] {
+	return func(yield func(Interval[T]) bool) {
+		if o.IsEmpty() {
+			return
+		}
+		t.root.overlapping(o.First(), o.Last(), yield)
+	}
+}
+
+func (node *treeNode[T]) overlapping(first, last T, yield func(Interval[T]) bool) bool {
+	if node == nil {
+		return true
+	}
+
+	switch {
+	case last < node.center:
+		for _, i := range node.byFirst {
+			if i.First() > last {
+				break
+			}
+			if !yield(i) {
+				return false
+			}
+		}
+		return node.left.overlapping(first, last, yield)
+	case first > node.center:
+		for _, i := range node.byLast {
+			if i.Last() < first {
+				break
+			}
+			if !yield(i) {
+				return false
+			}
+		}
+		return node.right.overlapping(first, last, yield)
+	default:
+		// every interval of this node contains center, which is in [first, last]
+		for _, i := range node.byFirst {
+			if !yield(i) {
+				return false
+			}
+		}
+		return node.left.overlapping(first, last, yield) &&
+			node.right.overlapping(first, last, yield)
+	}
+}
@@ -0,0 +1,77 @@
+package interval
+
+import (
+	"iter"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+func sortedIntervals(seq iter.Seq[Interval[int]]) []Interval[int] {
+	var result []Interval[int]
+	for i := range seq {
+		result = append(result, i)
+	}
+	slices.SortFunc(result, func(a, b Interval[int]) int {
+		if a.Low != b.Low {
+			return a.Low - b.Low
+		}
+		return a.High - b.High
+	})
+	return result
+}
+
+func TestTreeAgainstBruteForce(t *testing.T) {
+	r := rand.New(rand.NewPCG(3, 4))
+
+	for range 200 {
+		intervals := randomIntervals(r, r.IntN(40))
+		tree := NewTree(intervals)
+
+		for n := -1; n <= modelSize; n++ {
+			var want []Interval[int]
+			for _, i := range intervals {
+				if i.Contains(n) {
+					want = append(want, i)
+				}
+			}
+			want = sortedIntervals(slices.Values(want))
+
+			if got := sortedIntervals(tree.Containing(n)); !slices.Equal(got, want) {
+				t.Fatalf("%v Containing(%d) got %v; want: %v", intervals, n, got, want)
+			}
+			if got := tree.Contains(n); got != (len(want) > 0) {
+				t.Fatalf("%v Contains(%d) got %v; want: %v", intervals, n, got, len(want) > 0)
+			}
+		}
+
+		for _, q := range randomIntervals(r, 20) {
+			var want []Interval[int]
+			for _, i := range intervals {
+				if i.Overlaps(q) {
+					want = append(want, i)
+				}
+			}
+			want = sortedIntervals(slices.Values(want))
+
+			if got := sortedIntervals(tree.Overlapping(q)); !slices.Equal(got, want) {
+				t.Fatalf("%v Overlapping(%s) got %v; want: %v", intervals, q, got, want)
+			}
+		}
+	}
+}
+
+func TestTreeStopsEarly(t *testing.T) {
+	tree := NewTree([]Interval[int]{New(1, 10), New(2, 9), New(3, 8), New(4, 7)})
+
+	count := 0
+	for range tree.Overlapping(New(5, 5)) {
+		count++
+		if count == 2 {
+			break
+		}
+	}
+	if count != 2 {
+		t.Errorf("want 2 iterations, got: %d", count)
+	}
+}
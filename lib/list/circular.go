@@ -0,0 +1,180 @@
+package list
+
+import (
+	"fmt"
+	"iter"
+)
+
+// Circular is a node of a circular doubly linked list, there is no first or
+// last node and any node can be used to refer to the whole list
+//
+// It is meant for puzzles where elements move around in a circle, like
+// placing marbles or mixing a sequence of numbers
+type Circular[T any] struct {
+	Value      T
+	next, prev *Circular[T]
+}
+
+// NewCircular returns the node of the first value of a circular list with all
+// the given values, or nil if there are no values
+func NewCircular[T any](values ...T) *Circular[T] {
+	if len(values) == 0 {
+		return nil
+	}
+	first := &Circular[T]{Value: values[0]}
+	first.next = first
+	first.prev = first
+	last := first
+	for _, value := range values[1:] {
+		last = last.InsertAfter(value)
+	}
+	return first
+}
+
+func (c *Circular[T]) Next() *Circular[T] {
+	return c.next
+}
+
+func (c *Circular[T]) Prev() *Circular[T] {
+	return c.prev
+}
+
+// Move returns the node n positions after c, or before c if n is negative
+func (c *Circular[T]) Move(n int) *Circular[T] {
+	node := c
+	for ; n > 0; n-- {
+		node = node.next
+	}
+	for ; n < 0; n++ {
+		node = node.prev
+	}
+	return node
+}
+
+// InsertAfter adds a new node with value right after c and returns it
+func (c *Circular[T]) InsertAfter(value T) *Circular[T] {
+	node := &Circular[T]{Value: value, prev: c, next: c.next}
+	c.next.prev = node
+	c.next = node
+	return node
+}
+
+// InsertBefore adds a new node with value right before c and returns it
+func (c *Circular[T]) InsertBefore(value T) *Circular[T] {
+	return c.prev.InsertAfter(value)
+}
+
+// Remove takes c out of its list, leaving it as a list of only one node, and
+// returns the node that was after it, or nil if c was the only node
+func (c *Circular[T]) Remove() *Circular[T] {
+	next := c.next
+	if next == c {
+		return nil
+	}
+	c.unlink()
+	return next
+}
+
+// Shift moves c n positions forward in its list, or backward if n is
+// negative, the other nodes keep their order
+//
+// As c is not counted while moving, shifting a list of length l by l-1 leaves
+// every node where it was
+func (c *Circular[T]) Shift(n int) {
+	others := c.Len() - 1
+	if others < 1 {
+		return
+	}
+	n %= others
+	if n == 0 {
+		return
+	}
+
+	prev := c.prev
+	c.unlink()
+	prev = prev.Move(n)
+
+	c.prev = prev
+	c.next = prev.next
+	prev.next.prev = c
+	prev.next = c
+}
+
+// Len returns the amount of nodes in the list of c, it takes O(n)
+func (c *Circular[T]) Len() int {
+	count := 1
+	for node := c.next; node != c; node = node.next {
+		count++
+	}
+	return count
+}
+
+// Find returns the first node, going forward from c, whose value matches, or
+// nil if there is none
+func (c *Circular[T]) Find(match func(T) bool) *Circular[T] {
+	for node := range c.Nodes() {
+		if match(node.Value) {
+			return node
+		}
+	}
+	return nil
+}
+
+// Reverse reverses the direction of the whole list in place, c keeps being
+// part of it
+func (c *Circular[T]) Reverse() {
+	node := c
+	for {
+		node.next, node.prev = node.prev, node.next
+		node = node.prev
+		if node == c {
+			return
+		}
+	}
+}
+
+func (c *Circular[T]) ToSlice() []T {
+	var r []T
+	for value := range c.All() {
+		r = append(r, value)
+	}
+	return r
+}
+
+// All iterates once around the list, starting at c
+func (c *Circular[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for node := range c.Nodes() {
+			if !yield(node.Value) {
+				return
+			}
+		}
+	}
+}
+
+// Nodes iterates once around the list of nodes, starting at c
+func (c *Circular[T]) Nodes() iter.Seq[*Circular[T]] {
+	return func(yield func(*Circular[T]) bool) {
+		node := c
+		for {
+			if !yield(node) {
+				return
+			}
+			node = node.next
+			if node == c {
+				return
+			}
+		}
+	}
+}
+
+func (c *Circular[T]) String() string {
+	return fmt.Sprint(c.ToSlice())
+}
+
+func (c *Circular[T]) unlink() {
+	c.prev.next = c.next
+	c.next.prev = c.prev
+	c.next = c
+	c.prev = c
+}
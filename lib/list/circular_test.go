@@ -0,0 +1,125 @@
+package list
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+)
+
+func TestCircular(t *testing.T) {
+	if c := NewCircular[int](); c != nil {
+		t.Errorf("want nil for no values, got: %v", c)
+	}
+
+	c := NewCircular(1, 2, 3)
+	if want := []int{1, 2, 3}; !slices.Equal(c.ToSlice(), want) {
+		t.Errorf("want: %v, got: %v", want, c.ToSlice())
+	}
+	if c.Len() != 3 || c.Prev().Value != 3 || c.Move(4).Value != 2 || c.Move(-4).Value != 3 {
+		t.Errorf("want a circle of 1, 2 and 3, got: %v", c)
+	}
+
+	c.InsertBefore(0)
+	c.InsertAfter(5)
+	if want := []int{1, 5, 2, 3, 0}; !slices.Equal(c.ToSlice(), want) {
+		t.Errorf("want: %v, got: %v", want, c.ToSlice())
+	}
+
+	next := c.Next().Remove()
+	if next.Value != 2 {
+		t.Errorf("want 2 after the removed node, got: %v", next.Value)
+	}
+	if want := []int{2, 3, 0, 1}; !slices.Equal(next.ToSlice(), want) {
+		t.Errorf("want: %v, got: %v", want, next.ToSlice())
+	}
+
+	if got := c.Find(func(v int) bool { return v == 3 }); got == nil || got.Value != 3 {
+		t.Errorf("want the node with 3, got: %v", got)
+	}
+	if got := c.Find(func(v int) bool { return v == 5 }); got != nil {
+		t.Errorf("want nil, got: %v", got)
+	}
+
+	c.Reverse()
+	if want := []int{1, 0, 3, 2}; !slices.Equal(c.ToSlice(), want) {
+		t.Errorf("want: %v, got: %v", want, c.ToSlice())
+	}
+
+	single := NewCircular(7)
+	if got := single.Remove(); got != nil {
+		t.Errorf("want nil removing the only node, got: %v", got)
+	}
+	single.Shift(3)
+	single.Reverse()
+	if want := []int{7}; !slices.Equal(single.ToSlice(), want) {
+		t.Errorf("want: %v, got: %v", want, single.ToSlice())
+	}
+}
+
+func TestShift(t *testing.T) {
+	for _, tc := range []struct {
+		n    int
+		want []int
+	}{
+		{n: 0, want: []int{0, 1, 2, 3, 4}},
+		{n: 1, want: []int{0, 2, 1, 3, 4}},
+		{n: 3, want: []int{0, 2, 3, 4, 1}},
+		{n: 4, want: []int{0, 1, 2, 3, 4}},
+		{n: -1, want: []int{0, 2, 3, 4, 1}},
+		{n: -2, want: []int{0, 2, 3, 1, 4}},
+		{n: 9, want: []int{0, 2, 1, 3, 4}},
+	} {
+		t.Run(fmt.Sprintf("Shift(%d)", tc.n), func(t *testing.T) {
+			zero := NewCircular(0, 1, 2, 3, 4)
+			zero.Next().Shift(tc.n)
+			if got := zero.ToSlice(); !slices.Equal(got, tc.want) {
+				t.Errorf("want: %v, got: %v", tc.want, got)
+			}
+		})
+	}
+}
+
+// TestMixing mixes the example of 2022 day 20, moving every number as many
+// positions as its value in the original order
+func TestMixing(t *testing.T) {
+	values := []int{1, 2, -3, 3, -2, 0, 4}
+	ring := NewCircular(values...)
+	nodes := slices.Collect(ring.Nodes())
+	for _, node := range nodes {
+		node.Shift(node.Value)
+	}
+
+	zero := ring.Find(func(v int) bool { return v == 0 })
+	if want := []int{0, 3, -2, 1, 2, -3, 4}; !slices.Equal(zero.ToSlice(), want) {
+		t.Errorf("want: %v, got: %v", want, zero.ToSlice())
+	}
+}
+
+// TestMarbles plays the marble game of 2018 day 9
+func TestMarbles(t *testing.T) {
+	for _, tc := range []struct {
+		players, last, want int
+	}{
+		{players: 9, last: 25, want: 32},
+		{players: 10, last: 1618, want: 8317},
+		{players: 13, last: 7999, want: 146373},
+	} {
+		t.Run(fmt.Sprintf("Marbles(%d,%d)", tc.players, tc.last), func(t *testing.T) {
+			scores := make([]int, tc.players)
+			current := NewCircular(0)
+			for marble := 1; marble <= tc.last; marble++ {
+				if marble%23 == 0 {
+					removed := current.Move(-7)
+					scores[marble%tc.players] += marble + removed.Value
+					current = removed.Remove()
+					continue
+				}
+				current = current.Next().InsertAfter(marble)
+			}
+
+			if got := slices.Max(scores); got != tc.want {
+				t.Errorf("want: %d, got: %d", tc.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,188 @@
+package list
+
+import (
+	"fmt"
+	"iter"
+)
+
+// Node is an element of a Doubly list
+type Node[T any] struct {
+	Value      T
+	next, prev *Node[T]
+	list       *Doubly[T]
+}
+
+// Next returns the node after n, or nil if n is the last one
+func (n *Node[T]) Next() *Node[T] {
+	if n.list == nil || n.next == &n.list.root {
+		return nil
+	}
+	return n.next
+}
+
+// Prev returns the node before n, or nil if n is the first one
+func (n *Node[T]) Prev() *Node[T] {
+	if n.list == nil || n.prev == &n.list.root {
+		return nil
+	}
+	return n.prev
+}
+
+// Doubly is a doubly linked list, its zero value is an empty list ready to
+// use
+//
+// The nodes are linked in a circle through a sentinel node, so adding or
+// removing any node is O(1) and there are no special cases at the ends
+type Doubly[T any] struct {
+	root Node[T]
+	size int
+}
+
+func NewDoubly[T any](values ...T) *Doubly[T] {
+	d := new(Doubly[T])
+	for _, value := range values {
+		d.PushBack(value)
+	}
+	return d
+}
+
+func (d *Doubly[T]) lazyInit() {
+	if d.root.next == nil {
+		d.root.next = &d.root
+		d.root.prev = &d.root
+	}
+}
+
+func (d *Doubly[T]) Len() int {
+	return d.size
+}
+
+// Front returns the first node, or nil if the list is empty
+func (d *Doubly[T]) Front() *Node[T] {
+	if d.size == 0 {
+		return nil
+	}
+	return d.root.next
+}
+
+// Back returns the last node, or nil if the list is empty
+func (d *Doubly[T]) Back() *Node[T] {
+	if d.size == 0 {
+		return nil
+	}
+	return d.root.prev
+}
+
+func (d *Doubly[T]) PushFront(value T) *Node[T] {
+	d.lazyInit()
+	return d.insert(value, &d.root)
+}
+
+func (d *Doubly[T]) PushBack(value T) *Node[T] {
+	d.lazyInit()
+	return d.insert(value, d.root.prev)
+}
+
+// InsertAfter adds a new node with value right after mark and returns it,
+// mark must be a node of d
+func (d *Doubly[T]) InsertAfter(value T, mark *Node[T]) *Node[T] {
+	d.checkNode(mark)
+	return d.insert(value, mark)
+}
+
+// InsertBefore adds a new node with value right before mark and returns it,
+// mark must be a node of d
+func (d *Doubly[T]) InsertBefore(value T, mark *Node[T]) *Node[T] {
+	d.checkNode(mark)
+	return d.insert(value, mark.prev)
+}
+
+// Remove removes n from the list and returns its value, n must be a node of d
+func (d *Doubly[T]) Remove(n *Node[T]) T {
+	d.checkNode(n)
+	n.prev.next = n.next
+	n.next.prev = n.prev
+	n.next = nil
+	n.prev = nil
+	n.list = nil
+	d.size--
+	return n.Value
+}
+
+// Find returns the first node whose value matches, or nil if there is none
+func (d *Doubly[T]) Find(match func(T) bool) *Node[T] {
+	for n := d.Front(); n != nil; n = n.Next() {
+		if match(n.Value) {
+			return n
+		}
+	}
+	return nil
+}
+
+// Reverse reverses the order of the nodes in place
+func (d *Doubly[T]) Reverse() {
+	if d.size < 2 {
+		return
+	}
+	n := &d.root
+	for {
+		n.next, n.prev = n.prev, n.next
+		n = n.prev
+		if n == &d.root {
+			return
+		}
+	}
+}
+
+func (d *Doubly[T]) ToSlice() []T {
+	r := make([]T, 0, d.size)
+	for value := range d.All() {
+		r = append(r, value)
+	}
+	return r
+}
+
+// All iterates over the values from the front to the back
+func (d *Doubly[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for n := d.Front(); n != nil; {
+			next := n.Next()
+			if !yield(n.Value) {
+				return
+			}
+			n = next
+		}
+	}
+}
+
+// Backward iterates over the values from the back to the front
+func (d *Doubly[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for n := d.Back(); n != nil; {
+			prev := n.Prev()
+			if !yield(n.Value) {
+				return
+			}
+			n = prev
+		}
+	}
+}
+
+func (d *Doubly[T]) String() string {
+	return fmt.Sprint(d.ToSlice())
+}
+
+// insert adds a new node with value after at
+func (d *Doubly[T]) insert(value T, at *Node[T]) *Node[T] {
+	n := &Node[T]{Value: value, prev: at, next: at.next, list: d}
+	at.next.prev = n
+	at.next = n
+	d.size++
+	return n
+}
+
+func (d *Doubly[T]) checkNode(n *Node[T]) {
+	if n == nil || n.list != d {
+		panic("list: node is not part of this list")
+	}
+}
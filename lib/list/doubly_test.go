@@ -0,0 +1,104 @@
+package list
+
+import (
+	"slices"
+	"testing"
+)
+
+func backward[T any](d *Doubly[T]) []T {
+	var r []T
+	for v := range d.Backward() {
+		r = append(r, v)
+	}
+	return r
+}
+
+func checkDoubly(t *testing.T, d *Doubly[int], want []int) {
+	t.Helper()
+	if got := d.ToSlice(); !slices.Equal(got, want) {
+		t.Errorf("want: %v, got: %v", want, got)
+	}
+	reversed := slices.Clone(want)
+	slices.Reverse(reversed)
+	if got := backward(d); !slices.Equal(got, reversed) {
+		t.Errorf("want backward: %v, got: %v", reversed, got)
+	}
+	if got := d.Len(); got != len(want) {
+		t.Errorf("want len: %d, got: %d", len(want), got)
+	}
+}
+
+func TestDoublyPushAndInsert(t *testing.T) {
+	var d Doubly[int]
+	checkDoubly(t, &d, nil)
+	if d.Front() != nil || d.Back() != nil {
+		t.Errorf("want no front or back in an empty list")
+	}
+
+	three := d.PushBack(3)
+	d.PushFront(1)
+	d.PushBack(5)
+	d.InsertBefore(2, three)
+	d.InsertAfter(4, three)
+	checkDoubly(t, &d, []int{1, 2, 3, 4, 5})
+
+	if d.Front().Value != 1 || d.Back().Value != 5 {
+		t.Errorf("want front 1 and back 5, got: %v and %v", d.Front().Value, d.Back().Value)
+	}
+	if d.Front().Prev() != nil || d.Back().Next() != nil {
+		t.Errorf("want no node before the front or after the back")
+	}
+	if three.Prev().Value != 2 || three.Next().Value != 4 {
+		t.Errorf("want 3 between 2 and 4, got: %v", d)
+	}
+}
+
+func TestDoublyRemove(t *testing.T) {
+	d := NewDoubly(1, 2, 3, 4)
+	if got := d.Remove(d.Front()); got != 1 {
+		t.Errorf("want: 1, got: %d", got)
+	}
+	if got := d.Remove(d.Back()); got != 4 {
+		t.Errorf("want: 4, got: %d", got)
+	}
+	checkDoubly(t, d, []int{2, 3})
+
+	// removing while iterating
+	d = NewDoubly(1, 2, 3, 4, 5, 6)
+	for n := d.Front(); n != nil; {
+		next := n.Next()
+		if n.Value%2 == 0 {
+			d.Remove(n)
+		}
+		n = next
+	}
+	checkDoubly(t, d, []int{1, 3, 5})
+
+	n := d.Front()
+	d.Remove(n)
+	defer func() {
+		if recover() == nil {
+			t.Errorf("removing a node twice didn't panic")
+		}
+	}()
+	d.Remove(n)
+}
+
+func TestDoublyFindAndReverse(t *testing.T) {
+	d := NewDoubly(1, 2, 3, 4)
+	if got := d.Find(func(v int) bool { return v > 2 }); got == nil || got.Value != 3 {
+		t.Errorf("want the node with 3, got: %v", got)
+	}
+	if got := d.Find(func(v int) bool { return v > 4 }); got != nil {
+		t.Errorf("want nil, got: %v", got)
+	}
+
+	d.Reverse()
+	checkDoubly(t, d, []int{4, 3, 2, 1})
+	d.PushBack(0)
+	checkDoubly(t, d, []int{4, 3, 2, 1, 0})
+
+	empty := NewDoubly[int]()
+	empty.Reverse()
+	checkDoubly(t, empty, nil)
+}
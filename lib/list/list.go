@@ -2,6 +2,7 @@ package list
 
 import (
 	"fmt"
+	"iter"
 	"strings"
 )
 
@@ -59,6 +60,84 @@ func (l *List[T]) Each(fn func(*List[T], T) bool) {
 	}
 }
 
+// InsertAfter adds a new node with value right after l and returns it
+func (l *List[T]) InsertAfter(value T) *List[T] {
+	node := New(value)
+	node.Next = l.Next
+	l.Next = node
+	return node
+}
+
+// RemoveNext removes the node after l and returns it, or nil if l is the last
+// node
+func (l *List[T]) RemoveNext() *List[T] {
+	node := l.Next
+	if node == nil {
+		return nil
+	}
+	l.Next = node.Next
+	node.Next = nil
+	return node
+}
+
+// Find returns the first node, starting at l, whose value matches, or nil if
+// there is none
+func (l *List[T]) Find(match func(T) bool) *List[T] {
+	for node := l; node != nil; node = node.Next {
+		if match(node.Value) {
+			return node
+		}
+	}
+	return nil
+}
+
+// Reverse reverses the list in place and returns its new first node, which
+// was the last one
+func (l *List[T]) Reverse() *List[T] {
+	var prev *List[T]
+	for node := l; node != nil; {
+		next := node.Next
+		node.Next = prev
+		prev = node
+		node = next
+	}
+	return prev
+}
+
+func (l *List[T]) ToSlice() []T {
+	var r []T
+	for value := range l.All() {
+		r = append(r, value)
+	}
+	return r
+}
+
+// All iterates over the values starting at l
+func (l *List[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for node := l; node != nil; node = node.Next {
+			if !yield(node.Value) {
+				return
+			}
+		}
+	}
+}
+
+// Nodes iterates over the nodes starting at l, the current node can be
+// removed with RemoveNext from the previous one without breaking the
+// iteration
+func (l *List[T]) Nodes() iter.Seq[*List[T]] {
+	return func(yield func(*List[T]) bool) {
+		for node := l; node != nil; {
+			next := node.Next
+			if !yield(node) {
+				return
+			}
+			node = next
+		}
+	}
+}
+
 func (l *List[T]) String() string {
 	type Stringer interface {
 		String() string
@@ -0,0 +1,103 @@
+package list
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+)
+
+func TestFromSlice(t *testing.T) {
+	for _, tc := range []struct {
+		values []int
+		want   string
+	}{
+		{values: nil, want: "[]"},
+		{values: []int{1}, want: "[1]"},
+		{values: []int{1, 2, 3}, want: "[1, 2, 3]"},
+	} {
+		t.Run(fmt.Sprintf("FromSlice(%v)", tc.values), func(t *testing.T) {
+			l := FromSlice(tc.values)
+			if got := l.String(); got != tc.want {
+				t.Errorf("want: %v, got: %v", tc.want, got)
+			}
+			if got := l.Len(); got != len(tc.values) {
+				t.Errorf("want len: %v, got: %v", len(tc.values), got)
+			}
+			if got := l.ToSlice(); !slices.Equal(got, tc.values) {
+				t.Errorf("want: %v, got: %v", tc.values, got)
+			}
+		})
+	}
+}
+
+func TestInsertAfterAndRemoveNext(t *testing.T) {
+	l := New(1)
+	three := l.InsertAfter(3)
+	l.InsertAfter(2)
+	three.InsertAfter(4)
+	if want := []int{1, 2, 3, 4}; !slices.Equal(l.ToSlice(), want) {
+		t.Errorf("want: %v, got: %v", want, l.ToSlice())
+	}
+
+	removed := l.RemoveNext()
+	if removed.Value != 2 || removed.Next != nil {
+		t.Errorf("want a detached node with 2, got: %v", removed)
+	}
+	if got := three.Next.RemoveNext(); got != nil {
+		t.Errorf("want nil removing after the last node, got: %v", got)
+	}
+	if want := []int{1, 3, 4}; !slices.Equal(l.ToSlice(), want) {
+		t.Errorf("want: %v, got: %v", want, l.ToSlice())
+	}
+}
+
+func TestFind(t *testing.T) {
+	l := FromSlice([]int{1, 2, 3, 4})
+	if got := l.Find(func(v int) bool { return v%2 == 0 }); got == nil || got.Value != 2 {
+		t.Errorf("want the node with 2, got: %v", got)
+	}
+	if got := l.Find(func(v int) bool { return v > 4 }); got != nil {
+		t.Errorf("want nil, got: %v", got)
+	}
+}
+
+func TestReverse(t *testing.T) {
+	for _, values := range [][]int{nil, {1}, {1, 2}, {1, 2, 3, 4}} {
+		t.Run(fmt.Sprintf("Reverse(%v)", values), func(t *testing.T) {
+			want := slices.Clone(values)
+			slices.Reverse(want)
+			if got := FromSlice(values).Reverse().ToSlice(); !slices.Equal(got, want) {
+				t.Errorf("want: %v, got: %v", want, got)
+			}
+		})
+	}
+}
+
+func TestNodesRemovingWhileIterating(t *testing.T) {
+	l := FromSlice([]int{1, 2, 2, 3, 2, 4})
+
+	// remove every node with 2 while iterating
+	prev := l
+	for node := range l.Nodes() {
+		if node.Value == 2 {
+			prev.RemoveNext()
+			continue
+		}
+		prev = node
+	}
+
+	if want := []int{1, 3, 4}; !slices.Equal(l.ToSlice(), want) {
+		t.Errorf("want: %v, got: %v", want, l.ToSlice())
+	}
+
+	var got []int
+	for v := range l.All() {
+		got = append(got, v)
+		if v == 3 {
+			break
+		}
+	}
+	if want := []int{1, 3}; !slices.Equal(got, want) {
+		t.Errorf("want: %v, got: %v", want, got)
+	}
+}
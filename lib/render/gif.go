@@ -0,0 +1,61 @@
+package render
+
+import (
+	"errors"
+	"image"
+	"image/color"
+	"image/gif"
+	"io"
+	"time"
+)
+
+// Recorder records frames of a grid while a simulation runs, to write them as
+// an animated GIF
+type Recorder struct {
+	colors color.Palette
+	index  [256]uint8
+	scale  int
+	delay  int // in 100ths of a second, as GIF wants it
+	frames []*image.Paletted
+}
+
+// NewRecorder returns a recorder that draws each frame with palette, each
+// cell being a square of scale pixels, and shows each frame for delay
+func NewRecorder(palette Palette, scale int, delay time.Duration) *Recorder {
+	colors, index := palette.colors()
+	return &Recorder{
+		colors: colors,
+		index:  index,
+		scale:  scale,
+		delay:  max(1, int(delay/(10*time.Millisecond))),
+	}
+}
+
+// Record draws g as the next frame
+func (r *Recorder) Record(g Grid) {
+	r.frames = append(r.frames, draw(g, r.colors, r.index, r.scale))
+}
+
+// Len returns the amount of frames recorded
+func (r *Recorder) Len() int {
+	return len(r.frames)
+}
+
+// WriteGIF writes every frame recorded to w as an animated GIF that loops
+// forever, the last frame is shown for a second before starting again
+func (r *Recorder) WriteGIF(w io.Writer) error {
+	if len(r.frames) == 0 {
+		return errors.New("render: no frames recorded")
+	}
+
+	delays := make([]int, len(r.frames))
+	for i := range delays {
+		delays[i] = r.delay
+	}
+	delays[len(delays)-1] = max(r.delay, 100)
+
+	return gif.EncodeAll(w, &gif.GIF{
+		Image: r.frames,
+		Delay: delays,
+	})
+}
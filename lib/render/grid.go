@@ -0,0 +1,92 @@
+// Package render draws the grids and shapes of the puzzles as images, to see
+// what a solution is doing on inputs too big to print
+package render
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"slices"
+)
+
+// Grid is anything made of cells that can be drawn one by one
+type Grid interface {
+	Size() (width, height int)
+	At(x, y int) byte
+}
+
+// Bytes is a Grid made of rows of bytes, like the lines of an input
+type Bytes [][]byte
+
+func (b Bytes) Size() (width, height int) {
+	for _, row := range b {
+		width = max(width, len(row))
+	}
+	return width, len(b)
+}
+
+func (b Bytes) At(x, y int) byte {
+	if y < 0 || y >= len(b) || x < 0 || x >= len(b[y]) {
+		return 0
+	}
+	return b[y][x]
+}
+
+// Palette is the color of each value of a cell, the values without a color
+// are drawn in black
+//
+// As the images are paletted it can have at most 255 colors
+type Palette map[byte]color.Color
+
+// colors returns the colors of the palette, black being the first one, and the
+// index of the color of each value
+func (p Palette) colors() (color.Palette, [256]uint8) {
+	if len(p) > 255 {
+		panic(fmt.Sprintf("render: palette has %d colors, only 255 are allowed", len(p)))
+	}
+
+	var index [256]uint8
+	colors := color.Palette{color.Black}
+	values := make([]byte, 0, len(p))
+	for v := range p {
+		values = append(values, v)
+	}
+	slices.Sort(values)
+	for _, v := range values {
+		index[v] = uint8(len(colors))
+		colors = append(colors, p[v])
+	}
+	return colors, index
+}
+
+// Image draws g with each cell being a square of scale pixels
+func Image(g Grid, palette Palette, scale int) *image.Paletted {
+	colors, index := palette.colors()
+	return draw(g, colors, index, scale)
+}
+
+func draw(g Grid, colors color.Palette, index [256]uint8, scale int) *image.Paletted {
+	scale = max(1, scale)
+	width, height := g.Size()
+	img := image.NewPaletted(image.Rect(0, 0, width*scale, height*scale), colors)
+	for y := range height {
+		for x := range width {
+			c := index[g.At(x, y)]
+			for py := y * scale; py < (y+1)*scale; py++ {
+				row := img.Pix[py*img.Stride:]
+				for px := x * scale; px < (x+1)*scale; px++ {
+					row[px] = c
+				}
+			}
+		}
+	}
+	return img
+}
+
+// PNG writes g to w as a PNG image, with each cell being a square of scale
+// pixels
+func PNG(w io.Writer, g Grid, palette Palette, scale int) error {
+	return png.Encode(w, Image(g, palette, scale))
+}
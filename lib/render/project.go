@@ -0,0 +1,35 @@
+package render
+
+import "math"
+
+// Vec3 is a point in 3D
+type Vec3 struct {
+	X, Y, Z float64
+}
+
+// Orthographic projects points in 3D to a drawing as seen from far away, so
+// the distances don't change with the depth
+//
+// With no rotation it looks along the Z axis, dropping Z. Yaw turns the
+// points around the Y axis and then Pitch around the X axis, both in radians
+type Orthographic struct {
+	Yaw, Pitch float64
+}
+
+// Isometric looks at the points from a corner, so the three axes are seen
+// with the same length
+var Isometric = Orthographic{Yaw: math.Pi / 4, Pitch: math.Atan(1 / math.Sqrt2)}
+
+// Project returns where v is drawn, and its depth, which is bigger the
+// farther away it is, to draw the closest points last
+func (o Orthographic) Project(v Vec3) (p Point, depth float64) {
+	sinY, cosY := math.Sincos(o.Yaw)
+	x := v.X*cosY - v.Z*sinY
+	z := v.X*sinY + v.Z*cosY
+
+	sinP, cosP := math.Sincos(o.Pitch)
+	y := v.Y*cosP - z*sinP
+	depth = v.Y*sinP + z*cosP
+
+	return Point{X: x, Y: y}, depth
+}
@@ -0,0 +1,108 @@
+package render
+
+import (
+	"bytes"
+	"image/color"
+	"image/gif"
+	"image/png"
+	"testing"
+	"time"
+)
+
+var palette = Palette{
+	'.': color.White,
+	'#': color.RGBA{R: 0xff, A: 0xff},
+}
+
+func TestPNG(t *testing.T) {
+	g := Bytes{
+		[]byte(".#"),
+		[]byte("#?"),
+	}
+
+	var buf bytes.Buffer
+	if err := PNG(&buf, g, palette, 3); err != nil {
+		t.Fatalf("can't write PNG: %v", err)
+	}
+	img, err := png.Decode(&buf)
+	if err != nil {
+		t.Fatalf("can't read PNG: %v", err)
+	}
+
+	if got := img.Bounds().Size(); got.X != 6 || got.Y != 6 {
+		t.Errorf("want a 6x6 image, got: %v", got)
+	}
+	for _, tc := range []struct {
+		x, y int
+		want color.Color
+	}{
+		{x: 0, y: 0, want: color.White},
+		{x: 2, y: 2, want: color.White},
+		{x: 3, y: 0, want: palette['#']},
+		{x: 5, y: 2, want: palette['#']},
+		{x: 0, y: 3, want: palette['#']},
+		{x: 4, y: 4, want: color.Black},
+	} {
+		if !sameColor(img.At(tc.x, tc.y), tc.want) {
+			t.Errorf("pixel %d,%d want: %v, got: %v", tc.x, tc.y, tc.want, img.At(tc.x, tc.y))
+		}
+	}
+}
+
+func sameColor(a, b color.Color) bool {
+	r1, g1, b1, a1 := a.RGBA()
+	r2, g2, b2, a2 := b.RGBA()
+	return r1 == r2 && g1 == g2 && b1 == b2 && a1 == a2
+}
+
+func TestBytesSize(t *testing.T) {
+	width, height := Bytes{[]byte("ab"), []byte("abcd"), nil}.Size()
+	if width != 4 || height != 3 {
+		t.Errorf("want 4x3, got: %dx%d", width, height)
+	}
+}
+
+func TestRecorder(t *testing.T) {
+	r := NewRecorder(palette, 2, 50*time.Millisecond)
+
+	var buf bytes.Buffer
+	if err := r.WriteGIF(&buf); err == nil {
+		t.Errorf("want an error writing a GIF without frames")
+	}
+
+	g := Bytes{[]byte("...")}
+	for x := range g[0] {
+		g[0][x] = '#'
+		r.Record(g)
+	}
+	if r.Len() != 3 {
+		t.Errorf("want 3 frames, got: %d", r.Len())
+	}
+
+	if err := r.WriteGIF(&buf); err != nil {
+		t.Fatalf("can't write GIF: %v", err)
+	}
+	anim, err := gif.DecodeAll(&buf)
+	if err != nil {
+		t.Fatalf("can't read GIF: %v", err)
+	}
+	if len(anim.Image) != 3 {
+		t.Fatalf("want 3 frames, got: %d", len(anim.Image))
+	}
+	if want := []int{5, 5, 100}; anim.Delay[0] != want[0] || anim.Delay[2] != want[2] {
+		t.Errorf("want delays: %v, got: %v", want, anim.Delay)
+	}
+
+	// each frame is drawn when recorded, not when written
+	for i, frame := range anim.Image {
+		for x := range 3 {
+			want := palette['#']
+			if x > i {
+				want = color.White
+			}
+			if !sameColor(frame.At(2*x, 0), want) {
+				t.Errorf("frame %d pixel %d want: %v, got: %v", i, x, want, frame.At(2*x, 0))
+			}
+		}
+	}
+}
@@ -0,0 +1,217 @@
+package render
+
+import (
+	"bufio"
+	"cmp"
+	"fmt"
+	"html"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Point is a point of a drawing, with Y growing down like on the screen
+type Point struct {
+	X, Y float64
+}
+
+// Style is how the shapes of a layer are drawn, colors are any SVG color like
+// "red" or "#ff0000" and an empty color draws nothing
+type Style struct {
+	Stroke  string
+	Fill    string
+	Width   float64 // width of the lines in pixels, 1 if zero
+	Opacity float64 // from 0 to 1, 1 if zero
+}
+
+// SVG is a drawing made of layers of shapes, written as an SVG image that
+// fits every shape no matter how big or small their coordinates are
+type SVG struct {
+	layers []*Layer
+	bounds bounds
+}
+
+// Layer is a group of shapes drawn with the same style, the layers are drawn
+// in the order they were created so the last one is on top
+type Layer struct {
+	name   string
+	style  Style
+	shapes []shape
+	svg    *SVG
+}
+
+type shapeKind byte
+
+const (
+	shapePoint shapeKind = iota
+	shapePolyline
+	shapePolygon
+	shapeLabel
+)
+
+type shape struct {
+	kind   shapeKind
+	points []Point
+	text   string
+}
+
+type bounds struct {
+	min, max Point
+	empty    bool
+}
+
+func NewSVG() *SVG {
+	return &SVG{bounds: bounds{empty: true}}
+}
+
+// Layer returns the layer with the given name, creating it with style if it
+// doesn't exist yet
+func (s *SVG) Layer(name string, style Style) *Layer {
+	for _, l := range s.layers {
+		if l.name == name {
+			return l
+		}
+	}
+	l := &Layer{name: name, style: style, svg: s}
+	s.layers = append(s.layers, l)
+	return l
+}
+
+// Point draws a dot at p, with its label next to it if it is not empty
+func (l *Layer) Point(p Point, label string) {
+	l.add(shape{kind: shapePoint, points: []Point{p}})
+	if label != "" {
+		l.Label(p, label)
+	}
+}
+
+// Line draws a line from a to b
+func (l *Layer) Line(a, b Point) {
+	l.Polyline([]Point{a, b})
+}
+
+// Polyline draws lines joining every point with the next one
+func (l *Layer) Polyline(points []Point) {
+	l.add(shape{kind: shapePolyline, points: points})
+}
+
+// Polygon draws lines joining every point with the next one and the last
+// point with the first one
+func (l *Layer) Polygon(points []Point) {
+	l.add(shape{kind: shapePolygon, points: points})
+}
+
+// Rect draws the rectangle with opposite corners a and b
+func (l *Layer) Rect(a, b Point) {
+	l.Polygon([]Point{a, {X: b.X, Y: a.Y}, b, {X: a.X, Y: b.Y}})
+}
+
+// Label writes text at p
+func (l *Layer) Label(p Point, text string) {
+	l.add(shape{kind: shapeLabel, points: []Point{p}, text: text})
+}
+
+func (l *Layer) add(s shape) {
+	for _, p := range s.points {
+		l.svg.bounds.add(p)
+	}
+	l.shapes = append(l.shapes, s)
+}
+
+func (b *bounds) add(p Point) {
+	if b.empty {
+		b.min, b.max, b.empty = p, p, false
+		return
+	}
+	b.min = Point{X: math.Min(b.min.X, p.X), Y: math.Min(b.min.Y, p.Y)}
+	b.max = Point{X: math.Max(b.max.X, p.X), Y: math.Max(b.max.Y, p.Y)}
+}
+
+// Write writes the drawing to w as an SVG image of up to size pixels wide or
+// tall, keeping its proportions
+func (s *SVG) Write(w io.Writer, size int) error {
+	b := s.bounds
+	if b.empty {
+		b = bounds{max: Point{X: 1, Y: 1}}
+	}
+
+	// the dots and labels are sized after the whole drawing, and a margin
+	// keeps the ones at the border inside of the image
+	extent := math.Max(math.Max(b.max.X-b.min.X, b.max.Y-b.min.Y), 1)
+	dot := extent / 200
+	margin := extent / 20
+	viewW := b.max.X - b.min.X + 2*margin
+	viewH := b.max.Y - b.min.Y + 2*margin
+	width := float64(size) * viewW / math.Max(viewW, viewH)
+	height := float64(size) * viewH / math.Max(viewW, viewH)
+
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, `<svg xmlns="http://www.w3.org/2000/svg" width="%s" height="%s" viewBox="%s %s %s %s">`+"\n",
+		num(width), num(height), num(b.min.X-margin), num(b.min.Y-margin), num(viewW), num(viewH))
+	for _, l := range s.layers {
+		l.write(bw, dot)
+	}
+	fmt.Fprintln(bw, "</svg>")
+	return bw.Flush()
+}
+
+func (l *Layer) write(w io.Writer, dot float64) {
+	st := l.style
+	width := st.Width
+	if width == 0 {
+		width = 1
+	}
+	opacity := st.Opacity
+	if opacity == 0 {
+		opacity = 1
+	}
+
+	fmt.Fprintf(w, `<g id="%s" stroke="%s" fill="%s" stroke-width="%s" opacity="%s" font-size="%s">`+"\n",
+		html.EscapeString(l.name), paint(st.Stroke), paint(st.Fill), num(width), num(opacity), num(3*dot))
+	for _, s := range l.shapes {
+		switch s.kind {
+		case shapePoint:
+			p := s.points[0]
+			// dots are filled with the stroke color, so they are seen even on
+			// layers without fill
+			fmt.Fprintf(w, `<circle cx="%s" cy="%s" r="%s" fill="%s" stroke="none"/>`+"\n",
+				num(p.X), num(p.Y), num(dot), paint(cmp.Or(st.Stroke, st.Fill)))
+		case shapePolyline, shapePolygon:
+			tag := "polyline"
+			if s.kind == shapePolygon {
+				tag = "polygon"
+			}
+			fmt.Fprintf(w, `<%s points="%s" vector-effect="non-scaling-stroke"/>`+"\n", tag, points(s.points))
+		case shapeLabel:
+			p := s.points[0]
+			fmt.Fprintf(w, `<text x="%s" y="%s" fill="%s" stroke="none">%s</text>`+"\n",
+				num(p.X+dot), num(p.Y-dot), paint(cmp.Or(st.Stroke, st.Fill)), html.EscapeString(s.text))
+		}
+	}
+	fmt.Fprintln(w, "</g>")
+}
+
+func points(ps []Point) string {
+	var buf strings.Builder
+	for i, p := range ps {
+		if i > 0 {
+			buf.WriteByte(' ')
+		}
+		buf.WriteString(num(p.X))
+		buf.WriteByte(',')
+		buf.WriteString(num(p.Y))
+	}
+	return buf.String()
+}
+
+func num(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+func paint(c string) string {
+	if c == "" {
+		return "none"
+	}
+	return html.EscapeString(c)
+}
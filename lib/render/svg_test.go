@@ -0,0 +1,109 @@
+package render
+
+import (
+	"bytes"
+	"encoding/xml"
+	"math"
+	"strings"
+	"testing"
+)
+
+type svgDoc struct {
+	Width   string     `xml:"width,attr"`
+	Height  string     `xml:"height,attr"`
+	ViewBox string     `xml:"viewBox,attr"`
+	Groups  []svgGroup `xml:"g"`
+}
+
+type svgGroup struct {
+	ID        string     `xml:"id,attr"`
+	Stroke    string     `xml:"stroke,attr"`
+	Fill      string     `xml:"fill,attr"`
+	Circles   []struct{} `xml:"circle"`
+	Polylines []struct {
+		Points string `xml:"points,attr"`
+	} `xml:"polyline"`
+	Polygons []struct {
+		Points string `xml:"points,attr"`
+	} `xml:"polygon"`
+	Texts []string `xml:"text"`
+}
+
+func TestSVG(t *testing.T) {
+	s := NewSVG()
+	loop := s.Layer("loop", Style{Stroke: "green", Fill: "#eeffee"})
+	loop.Polygon([]Point{{7, 1}, {11, 1}, {11, 7}, {9, 7}, {9, 5}, {2, 5}, {2, 3}, {7, 3}})
+	best := s.Layer("best", Style{Stroke: "red", Width: 2})
+	best.Rect(Point{2, 3}, Point{11, 5})
+	tiles := s.Layer("tiles", Style{Stroke: "red"})
+	tiles.Point(Point{7, 1}, "a<b")
+	tiles.Point(Point{11, 7}, "")
+	tiles.Polyline([]Point{{0, 0}, {1, 1}, {2, 0}})
+
+	if s.Layer("loop", Style{}) != loop {
+		t.Errorf("want the same layer for the same name")
+	}
+
+	var buf bytes.Buffer
+	if err := s.Write(&buf, 200); err != nil {
+		t.Fatalf("can't write SVG: %v", err)
+	}
+	var doc svgDoc
+	if err := xml.Unmarshal(buf.Bytes(), &doc); err != nil {
+		t.Fatalf("can't read SVG: %v\n%s", err, buf.String())
+	}
+
+	// the drawing goes from 0,0 to 11,7 with a margin of 11/20
+	if want := "-0.55 -0.55 12.1 8.1"; doc.ViewBox != want {
+		t.Errorf("want viewBox: %q, got: %q", want, doc.ViewBox)
+	}
+	if doc.Width != "200" || !strings.HasPrefix(doc.Height, "133.8") {
+		t.Errorf("want a 200 pixels wide image, got: %s x %s", doc.Width, doc.Height)
+	}
+
+	if len(doc.Groups) != 3 {
+		t.Fatalf("want 3 layers, got: %d", len(doc.Groups))
+	}
+	if g := doc.Groups[0]; g.ID != "loop" || g.Stroke != "green" || g.Fill != "#eeffee" || len(g.Polygons) != 1 {
+		t.Errorf("want the loop first, got: %+v", g)
+	}
+	if g := doc.Groups[1]; g.ID != "best" || g.Fill != "none" || len(g.Polygons) != 1 || g.Polygons[0].Points != "2,3 11,3 11,5 2,5" {
+		t.Errorf("want the best rectangle second, got: %+v", g)
+	}
+	if g := doc.Groups[2]; len(g.Circles) != 2 || len(g.Polylines) != 1 || len(g.Texts) != 1 || g.Texts[0] != "a<b" {
+		t.Errorf("want 2 tiles, a label and a line last, got: %+v", g)
+	}
+}
+
+func TestEmptySVG(t *testing.T) {
+	var buf bytes.Buffer
+	if err := NewSVG().Write(&buf, 100); err != nil {
+		t.Fatalf("can't write SVG: %v", err)
+	}
+	var doc svgDoc
+	if err := xml.Unmarshal(buf.Bytes(), &doc); err != nil {
+		t.Fatalf("can't read SVG: %v\n%s", err, buf.String())
+	}
+}
+
+func TestOrthographic(t *testing.T) {
+	near := func(a, b float64) bool { return math.Abs(a-b) < 1e-9 }
+
+	p, depth := Orthographic{}.Project(Vec3{X: 1, Y: 2, Z: 3})
+	if !near(p.X, 1) || !near(p.Y, 2) || !near(depth, 3) {
+		t.Errorf("want 1,2 at depth 3, got: %v at depth %v", p, depth)
+	}
+
+	p, depth = Orthographic{Yaw: math.Pi / 2}.Project(Vec3{X: 1, Y: 2, Z: 3})
+	if !near(p.X, -3) || !near(p.Y, 2) || !near(depth, 1) {
+		t.Errorf("want -3,2 at depth 1, got: %v at depth %v", p, depth)
+	}
+
+	// every axis has the same length from a corner
+	for _, v := range []Vec3{{X: 1}, {Y: 1}, {Z: 1}} {
+		p, _ := Isometric.Project(v)
+		if length := math.Hypot(p.X, p.Y); !near(length, math.Sqrt(2.0/3)) {
+			t.Errorf("want every axis with the same length, %v has %v", v, length)
+		}
+	}
+}
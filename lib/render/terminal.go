@@ -0,0 +1,211 @@
+package render
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"time"
+)
+
+// Drawer draws each state of a grid while a simulation runs, like a Recorder
+// or a Terminal
+type Drawer interface {
+	Draw(g Grid)
+}
+
+type drawerKey struct{}
+
+// NewContext returns a copy of ctx with d, so solvers can draw their grids
+// without knowing if anyone is watching
+func NewContext(ctx context.Context, d Drawer) context.Context {
+	return context.WithValue(ctx, drawerKey{}, d)
+}
+
+// FromContext returns the Drawer of ctx, or one that draws nothing
+func FromContext(ctx context.Context) Drawer {
+	if d, ok := ctx.Value(drawerKey{}).(Drawer); ok {
+		return d
+	}
+	return nothing{}
+}
+
+type nothing struct{}
+
+func (nothing) Draw(Grid) {}
+
+// Draw records g as the next frame, so a Recorder is a Drawer
+func (r *Recorder) Draw(g Grid) {
+	r.Record(g)
+}
+
+// Terminal draws a grid on an ANSI terminal, drawing each frame over the
+// previous one and highlighting the cells that changed between them
+//
+// It only shows the part of the grid that fits in its viewport, which scrolls
+// to follow the changes, or can be scrolled by hand with Scroll
+type Terminal struct {
+	w      *bufio.Writer
+	delay  time.Duration
+	next   time.Time
+	x, y   int // top left corner of the viewport
+	width  int
+	height int
+	prev   [][]byte
+	frames int
+	follow bool
+}
+
+// NewTerminal returns a terminal that draws in w at most fps frames per
+// second, waiting between frames, and shows width columns and height rows of
+// the grid. If fps is zero or negative it draws as fast as it can
+func NewTerminal(w io.Writer, fps, width, height int) *Terminal {
+	t := &Terminal{
+		w:      bufio.NewWriter(w),
+		width:  max(1, width),
+		height: max(1, height),
+		follow: true,
+	}
+	if fps > 0 {
+		t.delay = time.Second / time.Duration(fps)
+	}
+	return t
+}
+
+// Scroll moves the viewport dx columns and dy rows, after scrolling by hand
+// the viewport stops following the changes
+func (t *Terminal) Scroll(dx, dy int) {
+	t.x = max(0, t.x+dx)
+	t.y = max(0, t.y+dy)
+	t.follow = false
+}
+
+// Viewport returns the column and row of the top left corner of the viewport
+func (t *Terminal) Viewport() (x, y int) {
+	return t.x, t.y
+}
+
+// Draw draws g over the previous frame, waiting until it is time for the next
+// frame
+func (t *Terminal) Draw(g Grid) {
+	if t.delay > 0 {
+		if wait := time.Until(t.next); wait > 0 {
+			time.Sleep(wait)
+		}
+		t.next = time.Now().Add(t.delay)
+	}
+
+	width, height := g.Size()
+	changed := t.changes(g, width, height)
+	if t.follow {
+		t.followChanges(changed, width, height)
+	}
+
+	if t.frames == 0 {
+		// clear the screen and hide the cursor
+		t.w.WriteString("\x1b[2J\x1b[?25l")
+	}
+	t.frames++
+	t.w.WriteString("\x1b[H")
+
+	for y := t.y; y < t.y+t.height && y < height; y++ {
+		highlighted := false
+		for x := t.x; x < t.x+t.width && x < width; x++ {
+			v := g.At(x, y)
+			if isChanged := changed.contains(x, y) && t.frames > 1 && t.prev[y][x] != v; isChanged != highlighted {
+				highlighted = isChanged
+				if highlighted {
+					t.w.WriteString("\x1b[7m")
+				} else {
+					t.w.WriteString("\x1b[0m")
+				}
+			}
+			t.w.WriteByte(printable(v))
+		}
+		if highlighted {
+			t.w.WriteString("\x1b[0m")
+		}
+		t.w.WriteString("\x1b[K\n")
+	}
+	fmt.Fprintf(t.w, "\x1b[Kframe %d, columns %d-%d of %d, rows %d-%d of %d\n\x1b[J",
+		t.frames, t.x, min(t.x+t.width, width)-1, width, t.y, min(t.y+t.height, height)-1, height)
+	t.w.Flush()
+
+	t.save(g, width, height)
+}
+
+// Close shows the cursor again, the last frame is left on the screen
+func (t *Terminal) Close() error {
+	t.w.WriteString("\x1b[?25h")
+	return t.w.Flush()
+}
+
+// area is the smallest rectangle with every changed cell, or empty
+type area struct {
+	x0, y0, x1, y1 int
+	empty          bool
+}
+
+func (a area) contains(x, y int) bool {
+	return !a.empty && x >= a.x0 && x <= a.x1 && y >= a.y0 && y <= a.y1
+}
+
+func (t *Terminal) changes(g Grid, width, height int) area {
+	a := area{empty: true}
+	if len(t.prev) != height {
+		return a
+	}
+	for y := range height {
+		for x := range width {
+			if x >= len(t.prev[y]) || t.prev[y][x] == g.At(x, y) {
+				continue
+			}
+			if a.empty {
+				a = area{x0: x, y0: y, x1: x, y1: y}
+				continue
+			}
+			a.x0, a.y0 = min(a.x0, x), min(a.y0, y)
+			a.x1, a.y1 = max(a.x1, x), max(a.y1, y)
+		}
+	}
+	return a
+}
+
+// followChanges moves the viewport, if the changes are out of it, to have
+// them in its center
+func (t *Terminal) followChanges(a area, width, height int) {
+	if a.empty {
+		return
+	}
+	if a.x0 < t.x || a.x1 >= t.x+t.width {
+		t.x = clampStart((a.x0+a.x1)/2-t.width/2, t.width, width)
+	}
+	if a.y0 < t.y || a.y1 >= t.y+t.height {
+		t.y = clampStart((a.y0+a.y1)/2-t.height/2, t.height, height)
+	}
+}
+
+func clampStart(start, size, total int) int {
+	return max(0, min(start, total-size))
+}
+
+func (t *Terminal) save(g Grid, width, height int) {
+	if len(t.prev) != height {
+		t.prev = make([][]byte, height)
+	}
+	for y := range height {
+		if len(t.prev[y]) != width {
+			t.prev[y] = make([]byte, width)
+		}
+		for x := range width {
+			t.prev[y][x] = g.At(x, y)
+		}
+	}
+}
+
+func printable(v byte) byte {
+	if v < ' ' || v > '~' {
+		return ' '
+	}
+	return v
+}
@@ -0,0 +1,114 @@
+package render
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+// lines returns the rows of the last frame drawn, without escape codes except
+// the ones that highlight, which are shown as [ and ]
+func lines(out string) []string {
+	frame := out[strings.LastIndex(out, "\x1b[H")+len("\x1b[H"):]
+	frame = strings.NewReplacer(
+		"\x1b[7m", "[",
+		"\x1b[0m", "]",
+		"\x1b[K", "",
+		"\x1b[J", "",
+		"\x1b[2J", "",
+		"\x1b[?25l", "",
+	).Replace(frame)
+	return strings.Split(strings.TrimSuffix(frame, "\n"), "\n")
+}
+
+func TestTerminal(t *testing.T) {
+	var out strings.Builder
+	term := NewTerminal(&out, 0, 10, 10)
+
+	g := Bytes{
+		[]byte("...."),
+		[]byte(".#.."),
+	}
+	term.Draw(g)
+	if got, want := lines(out.String()), []string{"....", ".#..", "frame 1, columns 0-3 of 4, rows 0-1 of 2"}; !slices.Equal(got, want) {
+		t.Errorf("want: %q, got: %q", want, got)
+	}
+
+	g[0][2] = '#'
+	g[0][3] = '#'
+	g[1][1] = '.'
+	term.Draw(g)
+	if got, want := lines(out.String()), []string{"..[##]", ".[.]..", "frame 2, columns 0-3 of 4, rows 0-1 of 2"}; !slices.Equal(got, want) {
+		t.Errorf("want: %q, got: %q", want, got)
+	}
+
+	term.Draw(g)
+	if got, want := lines(out.String())[0], "..##"; got != want {
+		t.Errorf("want nothing highlighted: %q, got: %q", want, got)
+	}
+
+	if err := term.Close(); err != nil || !strings.HasSuffix(out.String(), "\x1b[?25h") {
+		t.Errorf("want the cursor shown after closing")
+	}
+}
+
+func TestTerminalFollowsChanges(t *testing.T) {
+	var out strings.Builder
+	term := NewTerminal(&out, 0, 4, 2)
+
+	g := make(Bytes, 10)
+	for y := range g {
+		g[y] = []byte(strings.Repeat(".", 20))
+	}
+	term.Draw(g)
+	g[8][15] = '#'
+	term.Draw(g)
+
+	if x, y := term.Viewport(); x != 13 || y != 7 {
+		t.Errorf("want the viewport at 13,7, got: %d,%d", x, y)
+	}
+	if got, want := lines(out.String()), []string{"....", "..[#].", "frame 2, columns 13-16 of 20, rows 7-8 of 10"}; !slices.Equal(got, want) {
+		t.Errorf("want: %q, got: %q", want, got)
+	}
+
+	// changes at the border keep the viewport inside of the grid
+	g[9][19] = '#'
+	term.Draw(g)
+	if x, y := term.Viewport(); x != 16 || y != 8 {
+		t.Errorf("want the viewport at 16,8, got: %d,%d", x, y)
+	}
+
+	// after scrolling by hand the viewport doesn't move anymore
+	term.Scroll(-16, -8)
+	g[9][0] = '#'
+	term.Draw(g)
+	if x, y := term.Viewport(); x != 0 || y != 0 {
+		t.Errorf("want the viewport at 0,0, got: %d,%d", x, y)
+	}
+}
+
+func TestTerminalFrameRate(t *testing.T) {
+	var out strings.Builder
+	term := NewTerminal(&out, 50, 10, 10)
+
+	start := time.Now()
+	for range 4 {
+		term.Draw(Bytes{[]byte(".")})
+	}
+	if elapsed := time.Since(start); elapsed < 60*time.Millisecond {
+		t.Errorf("want 4 frames at 50 fps to take at least 60ms, took: %v", elapsed)
+	}
+}
+
+func TestFromContext(t *testing.T) {
+	FromContext(context.Background()).Draw(Bytes{})
+
+	r := NewRecorder(Palette{}, 1, time.Second)
+	ctx := NewContext(context.Background(), r)
+	FromContext(ctx).Draw(Bytes{[]byte(".")})
+	if r.Len() != 1 {
+		t.Errorf("want the frame drawn in the recorder of the context")
+	}
+}
@@ -0,0 +1,120 @@
+package runner
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"runtime/pprof"
+	"runtime/trace"
+)
+
+// Profiles has the names of the files where the profiles of a part are
+// written, an empty name skips that profile
+//
+// The profiles are read with go tool pprof and the trace with go tool trace
+type Profiles struct {
+	CPU   string // CPU profile
+	Mem   string // memory profile, with every allocation since the program started
+	Trace string // execution trace
+
+	// MemBefore is the memory profile taken right before the part starts,
+	// when more than one part runs the ones before are in Mem too, so it
+	// has to be read with:
+	//
+	//	go tool pprof -diff_base MemBefore Mem
+	MemBefore string
+}
+
+// ProfilesIn returns every profile of p inside dir, named after the part like
+// "2025-day08-part1.cpu.pprof"
+func ProfilesIn(dir string, p Part) Profiles {
+	name := filepath.Join(dir, fmt.Sprintf("%d-day%02d-part%d", p.Year, p.Day, p.Part))
+	return Profiles{
+		CPU:       name + ".cpu.pprof",
+		Mem:       name + ".mem.pprof",
+		Trace:     name + ".trace",
+		MemBefore: name + ".before.pprof",
+	}
+}
+
+// start writes the memory profile before the part and starts the CPU profile
+// and the execution trace, the function it returns stops them and writes the
+// memory profile
+//
+// Only one CPU profile and one trace can be recorded at a time, so parts
+// being profiled can't run at the same time
+func (pr Profiles) start() (stop func() error, err error) {
+	if pr.MemBefore != "" {
+		if err := writeMemProfile(pr.MemBefore); err != nil {
+			return nil, err
+		}
+	}
+
+	var stops []func() error
+	stop = func() error {
+		var errs []error
+		for _, f := range stops {
+			errs = append(errs, f())
+		}
+		return errors.Join(errs...)
+	}
+
+	if pr.CPU != "" {
+		f, err := os.Create(pr.CPU)
+		if err != nil {
+			return nil, fmt.Errorf("can't create CPU profile: %w", err)
+		}
+		if err := pprof.StartCPUProfile(f); err != nil {
+			f.Close()
+			return nil, fmt.Errorf("can't start CPU profile: %w", err)
+		}
+		stops = append(stops, func() error {
+			pprof.StopCPUProfile()
+			return f.Close()
+		})
+	}
+
+	if pr.Trace != "" {
+		f, err := os.Create(pr.Trace)
+		if err != nil {
+			stop()
+			return nil, fmt.Errorf("can't create trace: %w", err)
+		}
+		if err := trace.Start(f); err != nil {
+			f.Close()
+			stop()
+			return nil, fmt.Errorf("can't start trace: %w", err)
+		}
+		stops = append(stops, func() error {
+			trace.Stop()
+			return f.Close()
+		})
+	}
+
+	if pr.Mem != "" {
+		stops = append(stops, func() error {
+			return writeMemProfile(pr.Mem)
+		})
+	}
+
+	return stop, nil
+}
+
+// writeMemProfile writes the allocs profile, with every allocation since the
+// program started, to filename
+func writeMemProfile(filename string) error {
+	f, err := os.Create(filename)
+	if err != nil {
+		return fmt.Errorf("can't create memory profile: %w", err)
+	}
+	defer f.Close()
+
+	// the memory profile is only updated after a garbage collection
+	runtime.GC()
+	if err := pprof.Lookup("allocs").WriteTo(f, 0); err != nil {
+		return fmt.Errorf("can't write memory profile: %w", err)
+	}
+	return nil
+}
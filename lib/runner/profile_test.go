@@ -0,0 +1,72 @@
+package runner
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestProfilesIn(t *testing.T) {
+	got := ProfilesIn("prof", Part{Year: 2025, Day: 8, Part: 1})
+	want := Profiles{
+		CPU:       filepath.Join("prof", "2025-day08-part1.cpu.pprof"),
+		Mem:       filepath.Join("prof", "2025-day08-part1.mem.pprof"),
+		Trace:     filepath.Join("prof", "2025-day08-part1.trace"),
+		MemBefore: filepath.Join("prof", "2025-day08-part1.before.pprof"),
+	}
+	if got != want {
+		t.Errorf("want: %v, got: %v", want, got)
+	}
+}
+
+func TestRunProfiles(t *testing.T) {
+	dir := t.TempDir()
+	parts := []Part{
+		{Year: 2025, Day: 8, Part: 1, Solve: Simple(func(string) int {
+			sum := 0
+			for i := range 1_000_000 {
+				sum += i % 7
+			}
+			return sum
+		})},
+		{Year: 2025, Day: 9, Part: 1, Solve: Simple(func(string) int {
+			return len(make([]byte, 1<<20))
+		})},
+	}
+	opts := Options{
+		Workers:  4,
+		Profiles: func(p Part) Profiles { return ProfilesIn(dir, p) },
+	}
+
+	for r := range Run(context.Background(), parts, opts) {
+		if r.Err != nil {
+			t.Fatalf("want no errors, got: %v", r)
+		}
+	}
+
+	for _, p := range parts {
+		profiles := ProfilesIn(dir, p)
+		for _, filename := range []string{profiles.CPU, profiles.Mem, profiles.Trace, profiles.MemBefore} {
+			info, err := os.Stat(filename)
+			if err != nil || info.Size() == 0 {
+				t.Errorf("want a profile in %q, got: %v", filename, err)
+			}
+		}
+	}
+}
+
+func TestRunProfilesError(t *testing.T) {
+	parts := []Part{{Year: 2025, Day: 1, Part: 1, Solve: Simple(func(string) int { return 0 })}}
+	opts := Options{
+		Profiles: func(p Part) Profiles {
+			return Profiles{CPU: filepath.Join(t.TempDir(), "missing", "cpu.pprof")}
+		},
+	}
+
+	for r := range Run(context.Background(), parts, opts) {
+		if r.Err == nil {
+			t.Errorf("want an error creating the profile, got: %v", r)
+		}
+	}
+}
@@ -0,0 +1,120 @@
+package runner
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// Progress counts how much of its work a solver did, it is safe to use from
+// many goroutines and every method does nothing on a nil *Progress, so
+// solvers can be called without one
+type Progress struct {
+	done  atomic.Int64
+	total atomic.Int64
+}
+
+// SetTotal sets the estimated amount of work the solver has to do
+func (p *Progress) SetTotal(total int) {
+	if p != nil {
+		p.total.Store(int64(total))
+	}
+}
+
+// Add adds n to the amount of work done
+func (p *Progress) Add(n int) {
+	if p != nil {
+		p.done.Add(int64(n))
+	}
+}
+
+// Value returns the amount of work done and the estimated total, which is
+// zero if it is unknown
+func (p *Progress) Value() (done, total int) {
+	if p == nil {
+		return 0, 0
+	}
+	return int(p.done.Load()), int(p.total.Load())
+}
+
+// Bars draws a progress bar for every running part on a single line, that is
+// drawn again on each call to Draw
+type Bars struct {
+	mu      sync.Mutex
+	w       io.Writer
+	width   int
+	running []tracked
+	drawn   bool
+}
+
+type tracked struct {
+	part     Part
+	progress *Progress
+}
+
+// NewBars returns bars that are drawn on w, usually a terminal, each bar
+// being width characters wide
+func NewBars(w io.Writer, width int) *Bars {
+	return &Bars{w: w, width: width}
+}
+
+// Track adds a bar for a part while it runs, it can be used as the Track
+// option of Run
+func (b *Bars) Track(p Part, progress *Progress) (untrack func()) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.running = append(b.running, tracked{part: p, progress: progress})
+
+	return func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		for i, t := range b.running {
+			if t.progress == progress {
+				b.running = append(b.running[:i], b.running[i+1:]...)
+				break
+			}
+		}
+	}
+}
+
+// Draw erases the line drawn before and draws the bars of the running parts
+func (b *Bars) Draw() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var line strings.Builder
+	line.WriteString("\r\x1b[K")
+	for i, t := range b.running {
+		if i > 0 {
+			line.WriteString("  ")
+		}
+		done, total := t.progress.Value()
+		line.WriteString(Bar(t.part.Name(), done, total, b.width))
+	}
+	io.WriteString(b.w, line.String())
+	b.drawn = len(b.running) > 0
+}
+
+// Clear erases the line drawn before, if any, so something else can be
+// written
+func (b *Bars) Clear() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.drawn {
+		io.WriteString(b.w, "\r\x1b[K")
+		b.drawn = false
+	}
+}
+
+// Bar returns a progress bar like "2025 Day  9.2 [#####     ] 50/100", if
+// the total is unknown only the amount of work done is shown
+func Bar(name string, done, total, width int) string {
+	if total <= 0 {
+		return fmt.Sprintf("%s [%s] %d", name, strings.Repeat("?", width), done)
+	}
+	filled := min(width, width*done/total)
+	return fmt.Sprintf("%s [%s%s] %d/%d", name,
+		strings.Repeat("#", filled), strings.Repeat(" ", width-filled), done, total)
+}
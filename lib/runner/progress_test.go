@@ -0,0 +1,59 @@
+package runner
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestBar(t *testing.T) {
+	for _, tc := range []struct {
+		done, total int
+		want        string
+	}{
+		{done: 0, total: 10, want: "p [          ] 0/10"},
+		{done: 5, total: 10, want: "p [#####     ] 5/10"},
+		{done: 12, total: 10, want: "p [##########] 12/10"},
+		{done: 7, total: 0, want: "p [??????????] 7"},
+	} {
+		t.Run(fmt.Sprintf("Bar(%d,%d)", tc.done, tc.total), func(t *testing.T) {
+			if got := Bar("p", tc.done, tc.total, 10); got != tc.want {
+				t.Errorf("want: %q, got: %q", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestNilProgress(t *testing.T) {
+	var p *Progress
+	p.SetTotal(10)
+	p.Add(1)
+	if done, total := p.Value(); done != 0 || total != 0 {
+		t.Errorf("want no progress, got: %d/%d", done, total)
+	}
+}
+
+func TestBars(t *testing.T) {
+	var w strings.Builder
+	bars := NewBars(&w, 4)
+
+	a, b := new(Progress), new(Progress)
+	a.SetTotal(4)
+	a.Add(2)
+	untrackA := bars.Track(Part{Year: 2025, Day: 1, Part: 1}, a)
+	bars.Track(Part{Year: 2025, Day: 2, Part: 1}, b)
+
+	bars.Draw()
+	if want := "\r\x1b[K2025 Day  1.1 [##  ] 2/4  2025 Day  2.1 [????] 0"; w.String() != want {
+		t.Errorf("want: %q, got: %q", want, w.String())
+	}
+
+	w.Reset()
+	untrackA()
+	bars.Draw()
+	bars.Clear()
+	bars.Clear()
+	if want := "\r\x1b[K2025 Day  2.1 [????] 0\r\x1b[K"; w.String() != want {
+		t.Errorf("want: %q, got: %q", want, w.String())
+	}
+}
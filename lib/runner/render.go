@@ -0,0 +1,44 @@
+package runner
+
+import (
+	"fmt"
+	"io"
+	"maps"
+)
+
+// Renderer writes to w a drawing of a part solving the input in filename,
+// like an SVG or an animated GIF
+type Renderer func(filename string, w io.Writer) error
+
+// renderKey is the part of a drawing, Part can't be a key as it has a func
+type renderKey struct {
+	year, day, part int
+}
+
+var renderers = map[renderKey]map[string]Renderer{}
+
+// RegisterRenderer adds a drawing of a part to the runner, ext is the extension
+// of the files it writes, like ".svg" or ".gif", it panics if the part already
+// has a drawing with that extension
+func RegisterRenderer(year, day, part int, ext string, render Renderer) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	key := renderKey{year, day, part}
+	if renderers[key] == nil {
+		renderers[key] = map[string]Renderer{}
+	}
+	if _, found := renderers[key][ext]; found {
+		p := Part{Year: year, Day: day, Part: part}
+		panic(fmt.Sprintf("runner: %s drawing of %s registered twice", ext, p.Name()))
+	}
+	renderers[key][ext] = render
+}
+
+// Renderers returns the drawings registered for p by their extension
+func Renderers(p Part) map[string]Renderer {
+	mu.Lock()
+	defer mu.Unlock()
+
+	return maps.Clone(renderers[renderKey{p.Year, p.Day, p.Part}])
+}
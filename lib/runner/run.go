@@ -0,0 +1,165 @@
+package runner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"runtime"
+	"time"
+)
+
+// Options changes how Run solves the parts
+type Options struct {
+	// Workers is the amount of parts solved at the same time, if it is zero
+	// or negative it uses one worker per CPU
+	Workers int
+
+	// Timeout is the time each part has to be solved, zero means no timeout
+	Timeout time.Duration
+
+	// Input returns the filename of the input of a part
+	Input func(Part) string
+
+	// Track, if not nil, is called when a part starts with the progress
+	// reported by its solver, and the function it returns when it ends
+	Track func(Part, *Progress) (untrack func())
+
+	// Profiles, if not nil, returns the profiles to record while each part is
+	// solved, as only one part can be profiled at a time it makes Run use a
+	// single worker
+	Profiles func(Part) Profiles
+}
+
+// Result is the answer of a part, or the error that stopped it
+type Result struct {
+	Part     Part
+	Answer   int
+	Duration time.Duration
+	Err      error
+}
+
+func (r Result) String() string {
+	switch {
+	case errors.Is(r.Err, context.Canceled):
+		return fmt.Sprintf("[%14s] %s: cancelled", r.Duration, r.Part.Name())
+	case errors.Is(r.Err, context.DeadlineExceeded):
+		return fmt.Sprintf("[%14s] %s: timed out", r.Duration, r.Part.Name())
+	case r.Err != nil:
+		return fmt.Sprintf("[%14s] %s: error: %v", r.Duration, r.Part.Name(), r.Err)
+	}
+	return fmt.Sprintf("[%14s] %s: %d", r.Duration, r.Part.Name(), r.Answer)
+}
+
+// Run solves every part with a pool of workers and yields the results in the
+// same order of parts, no matter which part ends first
+//
+// A part that takes longer than the timeout, or is still running or waiting
+// when ctx is done, results in the error of the context. The solvers are asked
+// to stop through their context, but the ones that can't be cancelled keep
+// running in the background while the rest of the parts are solved. If the
+// iteration stops early the parts that didn't start yet are not solved
+func Run(ctx context.Context, parts []Part, opts Options) iter.Seq[Result] {
+	return func(yield func(Result) bool) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		workers := opts.Workers
+		if workers <= 0 {
+			workers = runtime.GOMAXPROCS(0)
+		}
+		if opts.Profiles != nil {
+			workers = 1
+		}
+
+		// each part has its own buffered channel, so the workers never wait
+		// for the results to be yielded and the results can be read in order
+		results := make([]chan Result, len(parts))
+		for i := range results {
+			results[i] = make(chan Result, 1)
+		}
+
+		jobs := make(chan int)
+		go func() {
+			defer close(jobs)
+			for i := range parts {
+				jobs <- i
+			}
+		}()
+		for range min(workers, len(parts)) {
+			go func() {
+				for i := range jobs {
+					results[i] <- solve(ctx, parts[i], opts)
+				}
+			}()
+		}
+
+		for _, result := range results {
+			if !yield(<-result) {
+				return
+			}
+		}
+	}
+}
+
+// solve solves a part in its own goroutine, so it can stop waiting for it
+// when its context is done
+func solve(ctx context.Context, p Part, opts Options) Result {
+	result := Result{Part: p}
+	if err := ctx.Err(); err != nil {
+		result.Err = err
+		return result
+	}
+
+	if opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+	}
+
+	input := p.Input("input")
+	if opts.Input != nil {
+		input = opts.Input(p)
+	}
+
+	progress := new(Progress)
+	if opts.Track != nil {
+		defer opts.Track(p, progress)()
+	}
+
+	stopProfiles := func() error { return nil }
+	if opts.Profiles != nil {
+		var err error
+		stopProfiles, err = opts.Profiles(p).start()
+		if err != nil {
+			result.Err = err
+			return result
+		}
+	}
+
+	done := make(chan Result, 1)
+	start := time.Now()
+	go func() {
+		r := Result{Part: p}
+		defer func() {
+			if v := recover(); v != nil {
+				r.Err = fmt.Errorf("panic: %v", v)
+			}
+			r.Duration = time.Since(start)
+			done <- r
+		}()
+		r.Answer, r.Err = p.Solve(ctx, input, progress)
+	}()
+
+	select {
+	case result = <-done:
+	case <-ctx.Done():
+		result.Duration = time.Since(start)
+		result.Err = ctx.Err()
+	}
+
+	if err := stopProfiles(); err != nil && result.Err == nil {
+		result.Err = err
+	}
+	return result
+}
@@ -0,0 +1,178 @@
+package runner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func sleepingParts(sleeps ...time.Duration) []Part {
+	parts := make([]Part, len(sleeps))
+	for i, sleep := range sleeps {
+		parts[i] = Part{Year: 2025, Day: i + 1, Part: 1, Solve: Simple(func(string) int {
+			time.Sleep(sleep)
+			return i
+		})}
+	}
+	return parts
+}
+
+func TestRunKeepsTheOrder(t *testing.T) {
+	parts := sleepingParts(30*time.Millisecond, 0, 20*time.Millisecond, 0, 10*time.Millisecond)
+
+	i := 0
+	for r := range Run(context.Background(), parts, Options{Workers: 3}) {
+		if r.Err != nil || r.Answer != i || r.Part.Day != i+1 {
+			t.Errorf("want answer %d of day %d, got: %v", i, i+1, r)
+		}
+		i++
+	}
+	if i != len(parts) {
+		t.Errorf("want %d results, got: %d", len(parts), i)
+	}
+}
+
+func TestRunLimitsTheWorkers(t *testing.T) {
+	var running, most atomic.Int32
+	parts := make([]Part, 20)
+	for i := range parts {
+		parts[i] = Part{Year: 2025, Day: i + 1, Part: 1, Solve: Simple(func(string) int {
+			n := running.Add(1)
+			for {
+				m := most.Load()
+				if n <= m || most.CompareAndSwap(m, n) {
+					break
+				}
+			}
+			time.Sleep(time.Millisecond)
+			running.Add(-1)
+			return 0
+		})}
+	}
+
+	for range Run(context.Background(), parts, Options{Workers: 4}) {
+	}
+	if got := most.Load(); got > 4 {
+		t.Errorf("want at most 4 parts at the same time, got: %d", got)
+	}
+}
+
+func TestRunTimeout(t *testing.T) {
+	parts := sleepingParts(0, time.Second, 0)
+
+	start := time.Now()
+	var got []error
+	for r := range Run(context.Background(), parts, Options{Workers: 1, Timeout: 20 * time.Millisecond}) {
+		got = append(got, r.Err)
+	}
+
+	if got[0] != nil || got[2] != nil {
+		t.Errorf("want no errors for the fast parts, got: %v", got)
+	}
+	if !errors.Is(got[1], context.DeadlineExceeded) {
+		t.Errorf("want a timeout for the slow part, got: %v", got[1])
+	}
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("want the slow part to not block the rest, took: %v", elapsed)
+	}
+}
+
+func TestRunCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	for r := range Run(ctx, sleepingParts(0, 0), Options{}) {
+		if !errors.Is(r.Err, context.Canceled) {
+			t.Errorf("want a cancelled result, got: %v", r)
+		}
+	}
+}
+
+func TestRunRecoversPanics(t *testing.T) {
+	parts := []Part{{Year: 2025, Day: 1, Part: 1, Solve: Simple(func(string) int {
+		panic("boom")
+	})}}
+
+	for r := range Run(context.Background(), parts, Options{}) {
+		if r.Err == nil || r.Err.Error() != "panic: boom" {
+			t.Errorf("want the panic as error, got: %v", r)
+		}
+	}
+}
+
+func TestRunInput(t *testing.T) {
+	var got string
+	parts := []Part{{Year: 2025, Day: 3, Part: 1, Solve: Simple(func(filename string) int {
+		got = filename
+		return 0
+	})}}
+	opts := Options{Input: func(p Part) string { return fmt.Sprintf("y%d/day%d", p.Year, p.Day) }}
+
+	for range Run(context.Background(), parts, opts) {
+	}
+	if want := "y2025/day3"; got != want {
+		t.Errorf("want: %q, got: %q", want, got)
+	}
+}
+
+func TestRunStopsContextSolvers(t *testing.T) {
+	stopped := make(chan error, 1)
+	var tracked *Progress
+	parts := []Part{{Year: 2025, Day: 9, Part: 2, Solve: func(ctx context.Context, _ string, progress *Progress) (int, error) {
+		progress.SetTotal(1000)
+		for {
+			if err := ctx.Err(); err != nil {
+				stopped <- err
+				return 0, err
+			}
+			progress.Add(1)
+			time.Sleep(time.Millisecond)
+		}
+	}}}
+	opts := Options{
+		Timeout: 20 * time.Millisecond,
+		Track: func(_ Part, progress *Progress) func() {
+			tracked = progress
+			return func() {}
+		},
+	}
+
+	for r := range Run(context.Background(), parts, opts) {
+		if want := "2025 Day  9.2: timed out"; !strings.HasSuffix(r.String(), want) {
+			t.Errorf("want a result that ends with %q, got: %q", want, r)
+		}
+	}
+
+	select {
+	case err := <-stopped:
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("want the solver to see the timeout, got: %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Errorf("want the solver to stop")
+	}
+	if done, total := tracked.Value(); done == 0 || total != 1000 {
+		t.Errorf("want the progress of the solver, got: %d/%d", done, total)
+	}
+}
+
+func TestResultString(t *testing.T) {
+	p := Part{Year: 2025, Day: 1, Part: 2}
+	for _, tc := range []struct {
+		result Result
+		want   string
+	}{
+		{result: Result{Part: p, Answer: 42}, want: "2025 Day  1.2: 42"},
+		{result: Result{Part: p, Err: context.Canceled}, want: "2025 Day  1.2: cancelled"},
+		{result: Result{Part: p, Err: fmt.Errorf("waiting: %w", context.DeadlineExceeded)}, want: "2025 Day  1.2: timed out"},
+		{result: Result{Part: p, Err: errors.New("bad input")}, want: "2025 Day  1.2: error: bad input"},
+	} {
+		if got := tc.result.String(); !strings.HasSuffix(got, tc.want) {
+			t.Errorf("want a result that ends with %q, got: %q", tc.want, got)
+		}
+	}
+}
@@ -0,0 +1,103 @@
+// Package runner keeps the parts of every puzzle solved so far, each year
+// registers its parts from an init function so a single binary, or test, can
+// run all of them
+package runner
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"sync"
+)
+
+// Solver solves a part of a puzzle reading its input from filename
+//
+// Solvers that take a while should stop and return the error of ctx as soon
+// as it is done, and report how much of their work is done to progress, which
+// may be nil
+type Solver func(ctx context.Context, filename string, progress *Progress) (int, error)
+
+// Simple returns a Solver for a solution that can't be cancelled and doesn't
+// report its progress
+func Simple(solve func(filename string) int) Solver {
+	return func(_ context.Context, filename string, _ *Progress) (int, error) {
+		return solve(filename), nil
+	}
+}
+
+// Part is a registered part of the puzzle of a day
+type Part struct {
+	Year  int
+	Day   int
+	Part  int
+	Solve Solver
+}
+
+// Name returns the name of the part, like "2025 Day  1.2"
+func (p Part) Name() string {
+	return fmt.Sprintf("%d Day %2d.%d", p.Year, p.Day, p.Part)
+}
+
+// Input returns the path of the input of the part inside the dir of inputs of
+// its year
+func (p Part) Input(dir string) string {
+	return filepath.Join(dir, fmt.Sprintf("day%d", p.Day))
+}
+
+var (
+	mu    sync.Mutex
+	parts []Part
+)
+
+// Register adds a part that can't be cancelled to the runner, it panics if the
+// part was already registered
+func Register(year, day, part int, solve func(filename string) int) {
+	RegisterContext(year, day, part, Simple(solve))
+}
+
+// RegisterContext adds a part to the runner, it panics if the part was already
+// registered
+func RegisterContext(year, day, part int, solve Solver) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	p := Part{Year: year, Day: day, Part: part, Solve: solve}
+	i, found := slices.BinarySearchFunc(parts, p, compare)
+	if found {
+		panic(fmt.Sprintf("runner: %s registered twice", p.Name()))
+	}
+	parts = slices.Insert(parts, i, p)
+}
+
+// Parts returns the registered parts that match year, day and part, sorted,
+// a zero matches any value
+func Parts(year, day, part int) []Part {
+	mu.Lock()
+	defer mu.Unlock()
+
+	var r []Part
+	for _, p := range parts {
+		if (year == 0 || p.Year == year) &&
+			(day == 0 || p.Day == day) &&
+			(part == 0 || p.Part == part) {
+			r = append(r, p)
+		}
+	}
+	return r
+}
+
+// YearDir returns the dir of the package of a year, relative to the root of
+// the repository
+func YearDir(root string, year int) string {
+	return filepath.Join(root, "years", fmt.Sprintf("y%d", year))
+}
+
+func compare(a, b Part) int {
+	return cmp.Or(
+		cmp.Compare(a.Year, b.Year),
+		cmp.Compare(a.Day, b.Day),
+		cmp.Compare(a.Part, b.Part),
+	)
+}
@@ -0,0 +1,73 @@
+package runner
+
+import (
+	"io"
+	"maps"
+	"slices"
+	"testing"
+)
+
+func TestRegister(t *testing.T) {
+	defer func(saved []Part) { parts = saved }(parts)
+	parts = nil
+
+	solve := func(string) int { return 0 }
+	Register(2025, 2, 1, solve)
+	Register(2024, 1, 2, solve)
+	Register(2025, 1, 2, solve)
+	Register(2025, 1, 1, solve)
+
+	var got []string
+	for _, p := range Parts(0, 0, 0) {
+		got = append(got, p.Name())
+	}
+	want := []string{"2024 Day  1.2", "2025 Day  1.1", "2025 Day  1.2", "2025 Day  2.1"}
+	if !slices.Equal(got, want) {
+		t.Errorf("want: %v, got: %v", want, got)
+	}
+
+	if got := len(Parts(2025, 1, 0)); got != 2 {
+		t.Errorf("want 2 parts for day 1 of 2025, got: %d", got)
+	}
+	if got := len(Parts(0, 0, 2)); got != 2 {
+		t.Errorf("want 2 second parts, got: %d", got)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("registering a part twice didn't panic")
+		}
+	}()
+	Register(2025, 1, 1, solve)
+}
+
+func TestInput(t *testing.T) {
+	p := Part{Year: 2025, Day: 10, Part: 1}
+	if got, want := p.Input(YearDir("aoc", p.Year)+"/input"), "aoc/years/y2025/input/day10"; got != want {
+		t.Errorf("want: %q, got: %q", want, got)
+	}
+}
+
+func TestRegisterRenderer(t *testing.T) {
+	defer func(saved map[renderKey]map[string]Renderer) { renderers = saved }(renderers)
+	renderers = map[renderKey]map[string]Renderer{}
+
+	draw := func(string, io.Writer) error { return nil }
+	RegisterRenderer(2025, 9, 1, ".svg", draw)
+	RegisterRenderer(2025, 9, 1, ".gif", draw)
+
+	got := slices.Sorted(maps.Keys(Renderers(Part{Year: 2025, Day: 9, Part: 1})))
+	if want := []string{".gif", ".svg"}; !slices.Equal(got, want) {
+		t.Errorf("want: %v, got: %v", want, got)
+	}
+	if got := Renderers(Part{Year: 2025, Day: 9, Part: 2}); len(got) != 0 {
+		t.Errorf("want no drawings for 2025 Day 9.2, got: %v", got)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("registering a drawing twice didn't panic")
+		}
+	}()
+	RegisterRenderer(2025, 9, 1, ".svg", draw)
+}
@@ -0,0 +1,40 @@
+package runner
+
+import (
+	"context"
+	"fmt"
+	"testing"
+)
+
+// Key identifies a part inside a year
+type Key struct {
+	Day, Part int
+}
+
+// TestAnswers runs every registered part of year with its input from dir and
+// compares the result with its answer, parts without an answer are skipped
+//
+// Solving the real inputs can take a while, so it is skipped with -short
+func TestAnswers(t *testing.T, year int, dir string, answers map[Key]int) {
+	t.Helper()
+	if testing.Short() {
+		t.Skip("skipping the real inputs in short mode")
+	}
+
+	for _, p := range Parts(year, 0, 0) {
+		t.Run(fmt.Sprintf("Day%dPart%d", p.Day, p.Part), func(t *testing.T) {
+			want, ok := answers[Key{Day: p.Day, Part: p.Part}]
+			if !ok {
+				t.Skipf("%s has no known answer", p.Name())
+			}
+			filename := p.Input(dir)
+			got, err := p.Solve(context.Background(), filename, nil)
+			if err != nil {
+				t.Fatalf("%s(%q) failed: %v", p.Name(), filename, err)
+			}
+			if got != want {
+				t.Errorf("%s(%q) got %d; want: %d", p.Name(), filename, got, want)
+			}
+		})
+	}
+}
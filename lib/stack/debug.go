@@ -0,0 +1,9 @@
+//go:build stackdebug
+
+package stack
+
+// underflow panics so that popping from an empty stack doesn't go unnoticed in
+// tests, see release.go for the default behaviour
+func underflow() {
+	panic("stack: underflow")
+}
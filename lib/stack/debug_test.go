@@ -0,0 +1,22 @@
+//go:build stackdebug
+
+package stack
+
+import "testing"
+
+func TestMustPopPanics(t *testing.T) {
+	for name, f := range map[string]func(*Stack[byte]){
+		"MustPop": func(s *Stack[byte]) { s.MustPop() },
+		"MustTop": func(s *Stack[byte]) { s.MustTop() },
+	} {
+		t.Run(name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s of an empty stack didn't panic", name)
+				}
+			}()
+			s := New[byte]()
+			f(&s)
+		})
+	}
+}
@@ -0,0 +1,234 @@
+package stack
+
+import "cmp"
+
+// Increasing keeps the elements strictly increasing from the bottom to the top
+func Increasing[T cmp.Ordered](below, above T) bool {
+	return below < above
+}
+
+// NonDecreasing keeps the elements increasing or equal from the bottom to the
+// top
+func NonDecreasing[T cmp.Ordered](below, above T) bool {
+	return below <= above
+}
+
+// Decreasing keeps the elements strictly decreasing from the bottom to the top
+func Decreasing[T cmp.Ordered](below, above T) bool {
+	return below > above
+}
+
+// NonIncreasing keeps the elements decreasing or equal from the bottom to the
+// top
+func NonIncreasing[T cmp.Ordered](below, above T) bool {
+	return below >= above
+}
+
+// Monotonic is a stack that keeps its elements ordered: pushing an element
+// first pops every element that would break the order
+//
+// The order is given by a function that reports whether above can be on top
+// of below, like Increasing or Decreasing
+type Monotonic[T any] struct {
+	data    Stack[T]
+	ordered func(below, above T) bool
+}
+
+func NewMonotonic[T any](ordered func(below, above T) bool) *Monotonic[T] {
+	return &Monotonic[T]{ordered: ordered}
+}
+
+func (m *Monotonic[T]) Len() int {
+	return m.data.Len()
+}
+
+// Top returns the element at the top, or false if the stack is empty
+func (m *Monotonic[T]) Top() (T, bool) {
+	return m.data.Top()
+}
+
+// Pop removes and returns the element at the top, or false if the stack is
+// empty
+func (m *Monotonic[T]) Pop() (T, bool) {
+	return m.data.Pop()
+}
+
+func (m *Monotonic[T]) Slice() []T {
+	return m.data.Slice()
+}
+
+// Push pops every element that would break the order with v and then pushes v
+func (m *Monotonic[T]) Push(v T) {
+	m.PushWhile(v, nil)
+}
+
+// PushWhile is like Push, but before popping each element it calls pop with it
+// and stops popping as soon as pop returns false, in which case v is pushed
+// even if it breaks the order
+func (m *Monotonic[T]) PushWhile(v T, pop func(T) bool) {
+	for top, ok := m.data.Top(); ok && !m.ordered(top, v); top, ok = m.data.Top() {
+		if pop != nil && !pop(top) {
+			break
+		}
+		m.data.Pop()
+	}
+	m.data.Push(v)
+}
+
+// MonotonicDeque is a double ended queue that keeps its elements ordered from
+// the front to the back: pushing an element at the back first removes from
+// the back every element that would break the order, and elements can be
+// removed from the front
+type MonotonicDeque[T any] struct {
+	data    []T
+	head    int
+	ordered func(front, back T) bool
+}
+
+func NewMonotonicDeque[T any](ordered func(front, back T) bool) *MonotonicDeque[T] {
+	return &MonotonicDeque[T]{ordered: ordered}
+}
+
+func (d *MonotonicDeque[T]) Len() int {
+	return len(d.data) - d.head
+}
+
+// Front returns the element at the front, or false if the deque is empty
+func (d *MonotonicDeque[T]) Front() (T, bool) {
+	if d.Len() == 0 {
+		var zero T
+		return zero, false
+	}
+	return d.data[d.head], true
+}
+
+// Back returns the element at the back, or false if the deque is empty
+func (d *MonotonicDeque[T]) Back() (T, bool) {
+	if d.Len() == 0 {
+		var zero T
+		return zero, false
+	}
+	return d.data[len(d.data)-1], true
+}
+
+// PushBack removes from the back every element that would break the order with
+// v and then adds v at the back
+func (d *MonotonicDeque[T]) PushBack(v T) {
+	for d.Len() > 0 && !d.ordered(d.data[len(d.data)-1], v) {
+		d.data = d.data[:len(d.data)-1]
+	}
+	d.data = append(d.data, v)
+}
+
+// PopFront removes and returns the element at the front, or false if the deque
+// is empty
+func (d *MonotonicDeque[T]) PopFront() (r T, ok bool) {
+	if d.Len() == 0 {
+		return
+	}
+	r = d.data[d.head]
+	d.head++
+
+	// reuse the space at the front once half of the buffer is unused
+	if d.head > len(d.data)/2 {
+		n := copy(d.data, d.data[d.head:])
+		d.data = d.data[:n]
+		d.head = 0
+	}
+	return r, true
+}
+
+// MaxSubsequence returns the subsequence of k elements of values, keeping
+// their relative order, that is the biggest when compared element by element
+//
+// For example, the biggest subsequence of 2 digits of 818181911112111 is 92
+func MaxSubsequence[T cmp.Ordered](values []T, k int) []T {
+	if k <= 0 {
+		return nil
+	}
+	if k >= len(values) {
+		return append([]T(nil), values...)
+	}
+
+	// every element can be dropped as long as there are enough elements left
+	// to complete the k elements
+	drops := len(values) - k
+	m := NewMonotonic(NonIncreasing[T])
+	for _, v := range values {
+		m.PushWhile(v, func(T) bool {
+			if drops == 0 {
+				return false
+			}
+			drops--
+			return true
+		})
+	}
+	return m.Slice()[:k]
+}
+
+// SlidingMax returns the maximum of every window of the given size, so
+// the result has len(values)-window+1 elements
+func SlidingMax[T cmp.Ordered](values []T, window int) []T {
+	return sliding(values, window, func(a, b T) bool { return a > b })
+}
+
+// SlidingMin returns the minimum of every window of the given size, so
+// the result has len(values)-window+1 elements
+func SlidingMin[T cmp.Ordered](values []T, window int) []T {
+	return sliding(values, window, func(a, b T) bool { return a < b })
+}
+
+// sliding keeps a deque with the indices of the values that can still be the
+// best of a window, the front being the best one
+func sliding[T any](values []T, window int, better func(a, b T) bool) []T {
+	if window <= 0 || window > len(values) {
+		return nil
+	}
+
+	result := make([]T, 0, len(values)-window+1)
+	d := NewMonotonicDeque(func(front, back int) bool {
+		return better(values[front], values[back])
+	})
+	for i := range values {
+		d.PushBack(i)
+		best, _ := d.Front()
+		if best <= i-window {
+			d.PopFront()
+			best, _ = d.Front()
+		}
+		if i >= window-1 {
+			result = append(result, values[best])
+		}
+	}
+	return result
+}
+
+// NextGreater returns, for each element of values, the index of the next
+// element that is strictly greater than it, or -1 if there is none
+func NextGreater[T cmp.Ordered](values []T) []int {
+	return next(values, NonIncreasing[T])
+}
+
+// NextSmaller returns, for each element of values, the index of the next
+// element that is strictly smaller than it, or -1 if there is none
+func NextSmaller[T cmp.Ordered](values []T) []int {
+	return next(values, NonDecreasing[T])
+}
+
+// next keeps a stack with the indices of the elements that haven't found
+// their next element yet, pushing an element pops every element for which it
+// is the next one
+func next[T any](values []T, ordered func(below, above T) bool) []int {
+	result := make([]int, len(values))
+	m := NewMonotonic(func(below, above int) bool {
+		return ordered(values[below], values[above])
+	})
+	for i := range values {
+		result[i] = -1
+		m.PushWhile(i, func(j int) bool {
+			result[j] = i
+			return true
+		})
+	}
+	return result
+}
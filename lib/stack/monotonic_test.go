@@ -0,0 +1,144 @@
+package stack
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/unkiwii/aoc/lib/combinations"
+)
+
+func randomValues(r *rand.Rand) []int {
+	values := make([]int, r.IntN(12))
+	for i := range values {
+		values[i] = r.IntN(5)
+	}
+	return values
+}
+
+func TestMonotonic(t *testing.T) {
+	m := NewMonotonic(Increasing[int])
+	for _, v := range []int{3, 1, 4, 1, 5, 9, 2, 6} {
+		m.Push(v)
+	}
+	if got, want := m.Slice(), []int{1, 2, 6}; !slices.Equal(got, want) {
+		t.Errorf("want: %v, got: %v", want, got)
+	}
+}
+
+func TestMaxSubsequence(t *testing.T) {
+	for _, tc := range []struct {
+		bank string
+		k    int
+		want string
+	}{
+		{bank: "987654321111111", k: 2, want: "98"},
+		{bank: "811111111111119", k: 2, want: "89"},
+		{bank: "234234234234278", k: 2, want: "78"},
+		{bank: "818181911112111", k: 2, want: "92"},
+		{bank: "987654321111111", k: 12, want: "987654321111"},
+		{bank: "811111111111119", k: 12, want: "811111111119"},
+		{bank: "234234234234278", k: 12, want: "434234234278"},
+		{bank: "818181911112111", k: 12, want: "888911112111"},
+	} {
+		t.Run(fmt.Sprintf("MaxSubsequence(%s,%d)", tc.bank, tc.k), func(t *testing.T) {
+			if got := string(MaxSubsequence([]byte(tc.bank), tc.k)); got != tc.want {
+				t.Errorf("want: %s, got: %s", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestMaxSubsequenceAgainstBruteForce(t *testing.T) {
+	r := rand.New(rand.NewPCG(9, 10))
+	for range 500 {
+		values := randomValues(r)
+		k := r.IntN(len(values) + 1)
+
+		var want []int
+		indices := make([]int, len(values))
+		for i := range indices {
+			indices[i] = i
+		}
+		for picked := range combinations.Combinations(k, indices) {
+			candidate := make([]int, k)
+			for i, index := range picked {
+				candidate[i] = values[index]
+			}
+			if want == nil || slices.Compare(candidate, want) > 0 {
+				want = candidate
+			}
+		}
+
+		if got := MaxSubsequence(values, k); !slices.Equal(got, want) {
+			t.Fatalf("MaxSubsequence(%v, %d) got %v; want: %v", values, k, got, want)
+		}
+	}
+}
+
+func TestSlidingAgainstBruteForce(t *testing.T) {
+	r := rand.New(rand.NewPCG(11, 12))
+	for range 500 {
+		values := randomValues(r)
+		window := 1 + r.IntN(len(values)+1)
+
+		var wantMax, wantMin []int
+		for i := 0; i+window <= len(values); i++ {
+			wantMax = append(wantMax, slices.Max(values[i:i+window]))
+			wantMin = append(wantMin, slices.Min(values[i:i+window]))
+		}
+
+		if got := SlidingMax(values, window); !slices.Equal(got, wantMax) {
+			t.Fatalf("SlidingMax(%v, %d) got %v; want: %v", values, window, got, wantMax)
+		}
+		if got := SlidingMin(values, window); !slices.Equal(got, wantMin) {
+			t.Fatalf("SlidingMin(%v, %d) got %v; want: %v", values, window, got, wantMin)
+		}
+	}
+}
+
+func TestNextAgainstBruteForce(t *testing.T) {
+	r := rand.New(rand.NewPCG(13, 14))
+	for range 500 {
+		values := randomValues(r)
+
+		wantGreater := make([]int, len(values))
+		wantSmaller := make([]int, len(values))
+		for i, v := range values {
+			wantGreater[i], wantSmaller[i] = -1, -1
+			for j := len(values) - 1; j > i; j-- {
+				if values[j] > v {
+					wantGreater[i] = j
+				}
+				if values[j] < v {
+					wantSmaller[i] = j
+				}
+			}
+		}
+
+		if got := NextGreater(values); !slices.Equal(got, wantGreater) {
+			t.Fatalf("NextGreater(%v) got %v; want: %v", values, got, wantGreater)
+		}
+		if got := NextSmaller(values); !slices.Equal(got, wantSmaller) {
+			t.Fatalf("NextSmaller(%v) got %v; want: %v", values, got, wantSmaller)
+		}
+	}
+}
+
+func TestMonotonicDeque(t *testing.T) {
+	d := NewMonotonicDeque(Decreasing[int])
+	for _, v := range []int{5, 3, 4, 1} {
+		d.PushBack(v)
+	}
+	var got []int
+	for v, ok := d.PopFront(); ok; v, ok = d.PopFront() {
+		got = append(got, v)
+	}
+	if want := []int{5, 4, 1}; !slices.Equal(got, want) {
+		t.Errorf("want: %v, got: %v", want, got)
+	}
+	if _, ok := d.Front(); ok {
+		t.Errorf("want an empty deque")
+	}
+}
@@ -0,0 +1,7 @@
+//go:build !stackdebug
+
+package stack
+
+// underflow does nothing, so MustPop and MustTop return the zero value on an
+// empty stack, build with -tags stackdebug to make them panic instead
+func underflow() {}
@@ -0,0 +1,15 @@
+//go:build !stackdebug
+
+package stack
+
+import "testing"
+
+func TestMustPopReturnsZero(t *testing.T) {
+	s := New[byte]()
+	if got := s.MustTop(); got != 0 {
+		t.Errorf("want: 0, got: %v", got)
+	}
+	if got := s.MustPop(); got != 0 {
+		t.Errorf("want: 0, got: %v", got)
+	}
+}
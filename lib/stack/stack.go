@@ -1,5 +1,10 @@
 package stack
 
+import (
+	"iter"
+	"slices"
+)
+
 func New[T any]() Stack[T] {
 	return Stack[T]{}
 }
@@ -16,23 +21,95 @@ func (s Stack[T]) Len() int {
 	return len(s)
 }
 
+func (s Stack[T]) IsEmpty() bool {
+	return len(s) == 0
+}
+
 func (s *Stack[T]) Push(v T) {
 	*s = append(*s, v)
 }
 
-func (s *Stack[T]) Pop() (r T) {
-	if len(*s) != 0 {
-		i := len(*s) - 1
-		r, *s = (*s)[i], (*s)[:i]
+// Pop removes and returns the element at the top, or false if the stack is
+// empty
+func (s *Stack[T]) Pop() (r T, ok bool) {
+	if len(*s) == 0 {
+		return
+	}
+	i := len(*s) - 1
+	r = (*s)[i]
+	var zero T
+	(*s)[i] = zero
+	*s = (*s)[:i]
+	return r, true
+}
+
+// Top returns the element at the top, or false if the stack is empty
+func (s Stack[T]) Top() (T, bool) {
+	return s.Peek(0)
+}
+
+// Peek returns the element n positions below the top, Peek(0) being the top,
+// or false if the stack doesn't have that many elements
+func (s Stack[T]) Peek(n int) (T, bool) {
+	i := len(s) - 1 - n
+	if n < 0 || i < 0 {
+		var zero T
+		return zero, false
 	}
-	return
+	return s[i], true
 }
 
-func (s Stack[T]) Top() T {
-	i := len(s) - 1
-	if i >= 0 {
-		return s[i]
+// MustPop is like Pop for when the stack can't be empty, if it is it returns
+// the zero value, or panics when built with the stackdebug tag
+func (s *Stack[T]) MustPop() T {
+	r, ok := s.Pop()
+	if !ok {
+		underflow()
+	}
+	return r
+}
+
+// MustTop is like Top for when the stack can't be empty, if it is it returns
+// the zero value, or panics when built with the stackdebug tag
+func (s Stack[T]) MustTop() T {
+	r, ok := s.Top()
+	if !ok {
+		underflow()
+	}
+	return r
+}
+
+// Clear removes every element, keeping the capacity
+func (s *Stack[T]) Clear() {
+	clear(*s)
+	*s = (*s)[:0]
+}
+
+// Reserve makes room for n more elements, so the next n pushes don't allocate
+func (s *Stack[T]) Reserve(n int) {
+	*s = slices.Grow(*s, n)
+}
+
+// FromTop iterates over the elements from the top to the bottom, the depth of
+// each element being the same used by Peek
+func (s Stack[T]) FromTop() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for depth := range len(s) {
+			if !yield(depth, s[len(s)-1-depth]) {
+				return
+			}
+		}
+	}
+}
+
+// FromBottom iterates over the elements from the bottom to the top, in the
+// same order they were pushed
+func (s Stack[T]) FromBottom() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i, v := range s {
+			if !yield(i, v) {
+				return
+			}
+		}
 	}
-	var zero T
-	return zero
 }
@@ -0,0 +1,99 @@
+package stack
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestPushPop(t *testing.T) {
+	s := New[int]()
+	if _, ok := s.Pop(); ok {
+		t.Errorf("Pop of an empty stack returned ok")
+	}
+	if _, ok := s.Top(); ok {
+		t.Errorf("Top of an empty stack returned ok")
+	}
+
+	for v := range 4 {
+		s.Push(v)
+	}
+	if top, ok := s.Top(); !ok || top != 3 {
+		t.Errorf("want top: 3, got: %v, %v", top, ok)
+	}
+
+	var got []int
+	for v, ok := s.Pop(); ok; v, ok = s.Pop() {
+		got = append(got, v)
+	}
+	if want := []int{3, 2, 1, 0}; !slices.Equal(got, want) {
+		t.Errorf("want: %v, got: %v", want, got)
+	}
+	if !s.IsEmpty() {
+		t.Errorf("want an empty stack, got: %v", s)
+	}
+}
+
+func TestPeek(t *testing.T) {
+	s := Stack[string]{"a", "b", "c"}
+	for _, tc := range []struct {
+		depth int
+		want  string
+		ok    bool
+	}{
+		{depth: 0, want: "c", ok: true},
+		{depth: 2, want: "a", ok: true},
+		{depth: 3, want: "", ok: false},
+		{depth: -1, want: "", ok: false},
+	} {
+		if got, ok := s.Peek(tc.depth); got != tc.want || ok != tc.ok {
+			t.Errorf("Peek(%d) want: %q, %v, got: %q, %v", tc.depth, tc.want, tc.ok, got, ok)
+		}
+	}
+}
+
+func TestIteration(t *testing.T) {
+	s := Stack[int]{1, 2, 3}
+
+	var top []int
+	for depth, v := range s.FromTop() {
+		if peek, _ := s.Peek(depth); peek != v {
+			t.Errorf("want Peek(%d) to be %d, got: %d", depth, v, peek)
+		}
+		top = append(top, v)
+	}
+	if want := []int{3, 2, 1}; !slices.Equal(top, want) {
+		t.Errorf("want: %v, got: %v", want, top)
+	}
+
+	var bottom []int
+	for _, v := range s.FromBottom() {
+		if v == 3 {
+			break
+		}
+		bottom = append(bottom, v)
+	}
+	if want := []int{1, 2}; !slices.Equal(bottom, want) {
+		t.Errorf("want: %v, got: %v", want, bottom)
+	}
+}
+
+func TestClearAndReserve(t *testing.T) {
+	s := New[int]()
+	s.Reserve(100)
+	if cap(s) < 100 {
+		t.Errorf("want capacity for 100 elements, got: %d", cap(s))
+	}
+
+	allocs := testing.AllocsPerRun(10, func() {
+		for v := range 100 {
+			s.Push(v)
+		}
+		s.Clear()
+	})
+	if allocs != 0 {
+		t.Errorf("want no allocations after Reserve, got: %v", allocs)
+	}
+	if s.Len() != 0 {
+		t.Errorf("want an empty stack after Clear, got: %v", s)
+	}
+}
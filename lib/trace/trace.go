@@ -0,0 +1,65 @@
+// Package trace logs the steps a solver takes, with structured fields, so they
+// can be compared with the walkthrough of the puzzle when an example fails
+//
+// Solvers log into the logger of their context, which discards everything
+// unless one was set with NewContext
+package trace
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+)
+
+type loggerKey struct{}
+
+// NewContext returns a copy of ctx with l, so solvers can log their steps
+// without knowing if anyone is reading them
+func NewContext(ctx context.Context, l *slog.Logger) context.Context {
+	return context.WithValue(ctx, loggerKey{}, l)
+}
+
+// FromContext returns the logger of ctx, or one that discards everything
+func FromContext(ctx context.Context) *slog.Logger {
+	if l, ok := ctx.Value(loggerKey{}).(*slog.Logger); ok {
+		return l
+	}
+	return discard
+}
+
+var discard = slog.New(slog.DiscardHandler)
+
+// Enabled reports if the steps logged with ctx are written somewhere, so
+// solvers can skip building the messages of their steps when they are not
+func Enabled(ctx context.Context) bool {
+	return FromContext(ctx).Enabled(ctx, slog.LevelInfo)
+}
+
+// Step logs msg, which should read like a line of the walkthrough, with the
+// fields in args given as key value pairs like in slog
+func Step(ctx context.Context, msg string, args ...any) {
+	FromContext(ctx).InfoContext(ctx, msg, args...)
+}
+
+// New returns a logger that writes each step to w as a line, in "text" or
+// "json" format
+//
+// The lines have no time nor level, so the traces of two runs can be diffed
+func New(w io.Writer, format string) (*slog.Logger, error) {
+	opts := &slog.HandlerOptions{ReplaceAttr: withoutTimeAndLevel}
+	switch format {
+	case "text":
+		return slog.New(slog.NewTextHandler(w, opts)), nil
+	case "json":
+		return slog.New(slog.NewJSONHandler(w, opts)), nil
+	}
+	return nil, fmt.Errorf("unknown trace format %q, expected text or json", format)
+}
+
+func withoutTimeAndLevel(groups []string, a slog.Attr) slog.Attr {
+	if len(groups) == 0 && (a.Key == slog.TimeKey || a.Key == slog.LevelKey) {
+		return slog.Attr{}
+	}
+	return a
+}
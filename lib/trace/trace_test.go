@@ -0,0 +1,50 @@
+package trace
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestSilentByDefault(t *testing.T) {
+	ctx := context.Background()
+	if Enabled(ctx) {
+		t.Errorf("want: disabled, got: enabled")
+	}
+	Step(ctx, "nobody reads this", "n", 1)
+}
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		format string
+		want   string
+	}{
+		{"text", "msg=\"The dial is rotated L68 to point at 82\" rotation=L68 point=82\n"},
+		{"json", "{\"msg\":\"The dial is rotated L68 to point at 82\",\"rotation\":\"L68\",\"point\":82}\n"},
+	}
+	for _, test := range tests {
+		t.Run(test.format, func(t *testing.T) {
+			var out strings.Builder
+			l, err := New(&out, test.format)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			ctx := NewContext(context.Background(), l)
+			if !Enabled(ctx) {
+				t.Errorf("want: enabled, got: disabled")
+			}
+			Step(ctx, "The dial is rotated L68 to point at 82", "rotation", "L68", "point", 82)
+
+			if got := out.String(); got != test.want {
+				t.Errorf("want: %q, got: %q", test.want, got)
+			}
+		})
+	}
+}
+
+func TestNewUnknownFormat(t *testing.T) {
+	if _, err := New(&strings.Builder{}, "xml"); err == nil {
+		t.Errorf("want: error, got: nil")
+	}
+}
@@ -0,0 +1,18 @@
+package y2024
+
+import (
+	"testing"
+
+	"github.com/unkiwii/aoc/lib/runner"
+)
+
+func TestAnswers(t *testing.T) {
+	runner.TestAnswers(t, 2024, "input", map[runner.Key]int{
+		{Day: 1, Part: 1}: 2166959,
+		{Day: 1, Part: 2}: 23741109,
+		{Day: 2, Part: 1}: 463,
+		{Day: 2, Part: 2}: 514,
+		{Day: 3, Part: 1}: 167650499,
+		{Day: 3, Part: 2}: 95846796,
+	})
+}
@@ -1,14 +1,10 @@
-package main
+package y2024
 
 import (
-	"bufio"
-	"bytes"
-	"io"
 	"log"
-	"os"
-	"strconv"
 
 	"github.com/unkiwii/aoc/lib/heap"
+	"github.com/unkiwii/aoc/lib/input"
 )
 
 // --- Day 1: Historian Hysteria ---
@@ -174,36 +170,23 @@ func Day1Part2(filename string) int {
 	})
 }
 
+// day1Pair is a line of the input, with a location id of each list
+type day1Pair struct {
+	Left, Right int
+}
+
 func day1(filename string, predicate func(left, right *heap.Heap[int]) int) int {
-	file, err := os.Open(filename)
+	pairs, err := input.Scan[day1Pair](filename, "%d %d")
 	if err != nil {
-		log.Fatalf("can't open file %q: %v", filename, err)
+		log.Fatalf("can't read lists: %v", err)
 	}
 
 	left := heap.New[int]()
 	right := heap.New[int]()
-
-	r := bufio.NewReader(file)
-	for {
-		line, _, err := r.ReadLine()
-		if err == io.EOF {
-			return predicate(left, right)
-		}
-		if err != nil {
-			log.Fatalf("can't read line: %v", err)
-		}
-
-		parts := bytes.Split(line, []byte(" "))
-		l, err := strconv.Atoi(string(parts[0]))
-		if err != nil {
-			log.Fatalf("can't parse line's left value: %v", err)
-		}
-		left.PushItem(l)
-
-		r, err := strconv.Atoi(string(parts[3]))
-		if err != nil {
-			log.Fatalf("can't parse line's right value: %v", err)
-		}
-		right.PushItem(r)
+	for _, pair := range pairs {
+		left.PushItem(pair.Left)
+		right.PushItem(pair.Right)
 	}
+
+	return predicate(left, right)
 }
@@ -1,4 +1,4 @@
-package main
+package y2024
 
 import (
 	"testing"
@@ -1,7 +1,8 @@
-package main
+package y2024
 
 import (
 	"bufio"
+	"fmt"
 	"io"
 	"log"
 	"os"
@@ -71,11 +72,17 @@ loop:
 		if target == string(current) {
 			current = current[0:0]
 
-			left, ok := readNumber(r, ',')
+			left, ok, err := readNumber(r, ',')
+			if err != nil {
+				log.Fatalf("can't read mul from file %q: %v", filename, err)
+			}
 			if !ok {
 				continue loop
 			}
-			right, ok := readNumber(r, ')')
+			right, ok, err := readNumber(r, ')')
+			if err != nil {
+				log.Fatalf("can't read mul from file %q: %v", filename, err)
+			}
 			if !ok {
 				continue loop
 			}
@@ -152,11 +159,17 @@ loop:
 		case mulTarget == s:
 			current = current[0:0]
 			if enabled {
-				left, ok := readNumber(r, ',')
+				left, ok, err := readNumber(r, ',')
+				if err != nil {
+					log.Fatalf("can't read mul from file %q: %v", filename, err)
+				}
 				if !ok {
 					continue loop
 				}
-				right, ok := readNumber(r, ')')
+				right, ok, err := readNumber(r, ')')
+				if err != nil {
+					log.Fatalf("can't read mul from file %q: %v", filename, err)
+				}
 				if !ok {
 					continue loop
 				}
@@ -174,24 +187,31 @@ loop:
 
 var digits = []byte{'0', '1', '2', '3', '4', '5', '6', '7', '8', '9'}
 
-func readNumber(r *bufio.Reader, delim byte) (int, bool) {
+// readNumber reads the digits of a number until delim, it returns false if
+// something else comes before it, leaving that byte to be read again, or if
+// the input ends, corrupted memory is not an error
+func readNumber(r *bufio.Reader, delim byte) (int, bool, error) {
 	var accum []byte
 	for {
 		b, err := r.ReadByte()
+		if err == io.EOF {
+			return 0, false, nil
+		}
 		if err != nil {
-			log.Fatalf("can't read number: %v", err)
+			return 0, false, fmt.Errorf("can't read number: %v", err)
 		}
 		if b == delim {
+			// an empty or too long number is not a number either
 			n, err := strconv.Atoi(string(accum))
 			if err != nil {
-				log.Fatalf("can't parse number: %v", err)
+				return 0, false, nil
 			}
-			return n, true
+			return n, true, nil
 		}
 		accum = append(accum, b)
 		if !slices.Contains(digits, b) {
 			r.UnreadByte()
-			return 0, false
+			return 0, false, nil
 		}
 	}
 }
@@ -0,0 +1,86 @@
+package y2024
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestDay3Part1(t *testing.T) {
+	filename := "input/day3.test"
+	want := 161
+	got := Day3Part1(filename)
+	if got != want {
+		t.Errorf("Day3Part1(%q) got %d; want: %d", filename, got, want)
+	}
+}
+
+func TestDay3Part2(t *testing.T) {
+	filename := "input/day3p2.test"
+	want := 48
+	got := Day3Part2(filename)
+	if got != want {
+		t.Errorf("Day3Part2(%q) got %d; want: %d", filename, got, want)
+	}
+}
+
+func TestReadNumber(t *testing.T) {
+	for _, tc := range []struct {
+		input string
+		n     int
+		ok    bool
+		next  string
+	}{
+		{input: "12,3)", n: 12, ok: true, next: "3)"},
+		{input: "1x,3)", n: 0, ok: false, next: "x,3)"},
+		{input: ",3)", n: 0, ok: false, next: "3)"},
+		{input: "12", n: 0, ok: false, next: ""},
+		{input: "99999999999999999999,1)", n: 0, ok: false, next: "1)"},
+	} {
+		t.Run(fmt.Sprintf("readNumber(%q)", tc.input), func(t *testing.T) {
+			r := bufio.NewReader(strings.NewReader(tc.input))
+			n, ok, err := readNumber(r, ',')
+			if err != nil {
+				t.Fatalf("want: no error, got: %v", err)
+			}
+			if n != tc.n || ok != tc.ok {
+				t.Errorf("want: %d, %v, got: %d, %v", tc.n, tc.ok, n, ok)
+			}
+			if next, _ := io.ReadAll(r); string(next) != tc.next {
+				t.Errorf("want: %q left to read, got: %q", tc.next, next)
+			}
+		})
+	}
+}
+
+func FuzzReadNumber(f *testing.F) {
+	for _, filename := range []string{"input/day3.test", "input/day3p2.test"} {
+		data, err := os.ReadFile(filename)
+		if err != nil {
+			f.Fatalf("can't read seeds from %q: %v", filename, err)
+		}
+		// readNumber reads what comes after each mul(
+		for _, after := range strings.Split(string(data), "mul(")[1:] {
+			f.Add(after)
+		}
+	}
+
+	f.Fuzz(func(t *testing.T, input string) {
+		r := bufio.NewReader(strings.NewReader(input))
+		n, ok, err := readNumber(r, ',')
+		if err != nil {
+			t.Fatalf("readNumber(%q) failed: %v", input, err)
+		}
+		if !ok {
+			return
+		}
+		digits, _, _ := strings.Cut(input, ",")
+		if want, err := strconv.Atoi(digits); err != nil || n != want || strings.ContainsAny(digits, "+-") {
+			t.Errorf("readNumber(%q) got %d; want: %q", input, n, digits)
+		}
+	})
+}
@@ -0,0 +1,12 @@
+package y2024
+
+import "github.com/unkiwii/aoc/lib/runner"
+
+func init() {
+	runner.Register(2024, 1, 1, Day1Part1)
+	runner.Register(2024, 1, 2, Day1Part2)
+	runner.Register(2024, 2, 1, Day2Part1)
+	runner.Register(2024, 2, 2, Day2Part2)
+	runner.Register(2024, 3, 1, Day3Part1)
+	runner.Register(2024, 3, 2, Day3Part2)
+}
@@ -0,0 +1,29 @@
+package y2025
+
+import (
+	"testing"
+
+	"github.com/unkiwii/aoc/lib/runner"
+)
+
+func TestAnswers(t *testing.T) {
+	runner.TestAnswers(t, 2025, "input", map[runner.Key]int{
+		{Day: 1, Part: 1}:  1029,
+		{Day: 1, Part: 2}:  5892,
+		{Day: 2, Part: 1}:  13108371860,
+		{Day: 2, Part: 2}:  22471660255,
+		{Day: 3, Part: 1}:  17766,
+		{Day: 3, Part: 2}:  176582889354075,
+		{Day: 4, Part: 1}:  1356,
+		{Day: 4, Part: 2}:  8713,
+		{Day: 5, Part: 1}:  868,
+		{Day: 5, Part: 2}:  354143734113772,
+		{Day: 6, Part: 1}:  4722948564882,
+		{Day: 6, Part: 2}:  9581313737063,
+		{Day: 7, Part: 1}:  1658,
+		{Day: 8, Part: 1}:  181584,
+		{Day: 8, Part: 2}:  8465902405,
+		{Day: 9, Part: 1}:  4767418746,
+		{Day: 10, Part: 1}: 415,
+	})
+}
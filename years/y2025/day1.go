@@ -1,11 +1,15 @@
-package main
+package y2025
 
 import (
 	"bufio"
+	"context"
+	"fmt"
 	"io"
 	"log"
 	"os"
 	"strconv"
+
+	"github.com/unkiwii/aoc/lib/trace"
 )
 
 // --- Day 1: Secret Entrance ---
@@ -97,6 +101,12 @@ import (
 // Analyze the rotations in your attached document. What's the actual password
 // to open the door?
 func Day1Part1(start int, filename string) int {
+	return Day1Part1Context(context.Background(), start, filename)
+}
+
+// Day1Part1Context is Day1Part1 but it traces every rotation to the logger of
+// ctx, like the example above
+func Day1Part1Context(ctx context.Context, start int, filename string) int {
 	file, err := os.Open(filename)
 	if err != nil {
 		log.Fatalf("can't open file %q: %v", filename, err)
@@ -104,15 +114,24 @@ func Day1Part1(start int, filename string) int {
 
 	pos := start
 	password := 0
+	if trace.Enabled(ctx) {
+		trace.Step(ctx, fmt.Sprintf("The dial starts by pointing at %d.", pos), "point", pos)
+	}
 
 	r := bufio.NewReader(file)
 	for {
-		sign := readSign(r)
-		if sign == 999 {
+		sign, err := readSign(r)
+		if err == io.EOF {
 			return password
 		}
+		if err != nil {
+			log.Fatalf("can't read rotation from file %q: %v", filename, err)
+		}
 
-		offset := readOffset(r)
+		offset, err := readOffset(r)
+		if err != nil {
+			log.Fatalf("can't read rotation from file %q: %v", filename, err)
+		}
 
 		pos = (pos + sign*offset) % 100
 		if pos < 0 {
@@ -121,6 +140,12 @@ func Day1Part1(start int, filename string) int {
 		if pos == 0 {
 			password++
 		}
+
+		if trace.Enabled(ctx) {
+			rotation := day1Rotation(sign, offset)
+			trace.Step(ctx, fmt.Sprintf("The dial is rotated %s to point at %d.", rotation, pos),
+				"rotation", rotation, "point", pos, "password", password)
+		}
 	}
 }
 
@@ -164,6 +189,12 @@ func Day1Part1(start int, filename string) int {
 //
 // Using password method 0x434C49434B, what is the password to open the door?
 func Day1Part2(start int, filename string) int {
+	return Day1Part2Context(context.Background(), start, filename)
+}
+
+// Day1Part2Context is Day1Part2 but it traces every rotation to the logger of
+// ctx, like the example above
+func Day1Part2Context(ctx context.Context, start int, filename string) int {
 	file, err := os.Open(filename)
 	if err != nil {
 		log.Fatalf("can't open file %q: %v", filename, err)
@@ -171,17 +202,28 @@ func Day1Part2(start int, filename string) int {
 
 	pos := start
 	password := 0
+	if trace.Enabled(ctx) {
+		trace.Step(ctx, fmt.Sprintf("The dial starts by pointing at %d.", pos), "point", pos)
+	}
 
 	r := bufio.NewReader(file)
 	for {
-		sign := readSign(r)
-		if sign == 999 {
+		sign, err := readSign(r)
+		if err == io.EOF {
 			return password
 		}
+		if err != nil {
+			log.Fatalf("can't read rotation from file %q: %v", filename, err)
+		}
 
-		offset := readOffset(r)
+		offset, err := readOffset(r)
+		if err != nil {
+			log.Fatalf("can't read rotation from file %q: %v", filename, err)
+		}
 
-		for range offset {
+		// times the dial points at 0 during the rotation, not at the end of it
+		during := 0
+		for i := range offset {
 			pos += sign
 			if pos < 0 {
 				pos += 100
@@ -191,44 +233,72 @@ func Day1Part2(start int, filename string) int {
 			}
 			if pos == 0 {
 				password++
+				if i < offset-1 {
+					during++
+				}
+			}
+		}
+
+		if trace.Enabled(ctx) {
+			rotation := day1Rotation(sign, offset)
+			msg := fmt.Sprintf("The dial is rotated %s to point at %d", rotation, pos)
+			switch during {
+			case 0:
+				msg += "."
+			case 1:
+				msg += "; during this rotation, it points at 0 once."
+			case 2:
+				msg += "; during this rotation, it points at 0 twice."
+			default:
+				msg += fmt.Sprintf("; during this rotation, it points at 0 %d times.", during)
 			}
+			trace.Step(ctx, msg, "rotation", rotation, "point", pos, "during", during, "password", password)
 		}
 	}
 }
 
-func readSign(r *bufio.Reader) int {
-	dir, size, err := r.ReadRune()
-	if err == io.EOF {
-		return 999
+// day1Rotation returns the rotation as it's written in the document, like L68
+func day1Rotation(sign, offset int) string {
+	if sign < 0 {
+		return "L" + strconv.Itoa(offset)
 	}
-	if size != 1 {
-		log.Fatalf("invalid rune size %d: expected 'L' or 'R' of size 1", size)
+	return "R" + strconv.Itoa(offset)
+}
+
+// readSign reads the direction of a rotation, 1 for R and -1 for L, it
+// returns io.EOF when there are no more rotations
+func readSign(r *bufio.Reader) (int, error) {
+	dir, _, err := r.ReadRune()
+	if err == io.EOF {
+		return 0, io.EOF
 	}
 	if err != nil {
-		log.Fatalf("can't read rune 'L' or 'R': %v", err)
+		return 0, fmt.Errorf("can't read rune 'L' or 'R': %v", err)
 	}
 	switch dir {
 	case 'R':
-		return 1
+		return 1, nil
 	case 'L':
-		return -1
+		return -1, nil
 	}
-
-	log.Fatalf("invalid direction %c", dir)
-	return 0
+	return 0, fmt.Errorf("invalid direction %q: expected 'L' or 'R'", dir)
 }
 
-func readOffset(r *bufio.Reader) int {
+// readOffset reads the clicks of a rotation until the end of the line
+func readOffset(r *bufio.Reader) (int, error) {
 	line, _, err := r.ReadLine()
 	if err == io.EOF {
-		return 0
+		return 0, io.ErrUnexpectedEOF
 	}
 	if err != nil {
-		log.Fatalf("can't read offset: %v", err)
+		return 0, fmt.Errorf("can't read offset: %v", err)
 	}
 	n, err := strconv.Atoi(string(line))
 	if err != nil {
-		log.Fatalf("invalid offset: %v", err)
+		return 0, fmt.Errorf("invalid offset: %v", err)
+	}
+	if n < 0 {
+		return 0, fmt.Errorf("invalid offset %d: expected clicks to be positive", n)
 	}
-	return n
+	return n, nil
 }
@@ -1,16 +1,18 @@
-package main
+package y2025
 
 import (
 	"bufio"
+	"context"
 	"fmt"
 	"io"
 	"log"
 	"os"
-	"slices"
 	"strconv"
 	"strings"
 
+	"github.com/unkiwii/aoc/lib/bitset"
 	"github.com/unkiwii/aoc/lib/combinations"
+	"github.com/unkiwii/aoc/lib/runner"
 )
 
 // --- Day 10: Factory ---
@@ -97,27 +99,53 @@ import (
 // What is the fewest button presses required to correctly configure the
 // indicator lights on all of the machines?
 func Day10Part1(filename string) int {
+	result, err := Day10Part1Context(context.Background(), filename, nil)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return result
+}
+
+// Day10Part1Context is Day10Part1 but it stops as soon as ctx is done and
+// reports every machine configured to progress
+func Day10Part1Context(ctx context.Context, filename string, progress *runner.Progress) (int, error) {
 	file, err := os.Open(filename)
 	if err != nil {
 		log.Fatalf("can't open file %q: %v", filename, err)
 	}
 
-	result := 0
+	var machines []Day10Machine
 
 	r := bufio.NewReader(file)
 	for {
 		line, _, err := r.ReadLine()
 		if err == io.EOF {
-			return result
+			break
 		}
 		if err != nil {
 			log.Fatalf("can't read line from file %q: %v", filename, err)
 		}
 
-		machine := NewDay10MachineFromLine(line)
-		presses := machine.FindFewestButtonPresses()
+		machine, err := NewDay10MachineFromLine(line)
+		if err != nil {
+			return 0, fmt.Errorf("can't parse machine %q from file %q: %w", line, filename, err)
+		}
+		machines = append(machines, machine)
+	}
+
+	progress.SetTotal(len(machines))
+
+	result := 0
+	for _, machine := range machines {
+		presses, err := machine.FindFewestButtonPresses(ctx)
+		if err != nil {
+			return 0, err
+		}
 		result += presses
+		progress.Add(1)
 	}
+
+	return result, nil
 }
 
 func Day10Part2(filename string) int {
@@ -141,9 +169,10 @@ func Day10Part2(filename string) int {
 }
 
 type Day10Machine struct {
-	state               []bool
-	endState            []bool
+	state               bitset.Fixed
+	endState            bitset.Fixed
 	buttonWirings       [][]int
+	buttons             []bitset.Fixed
 	joltageRequirements []int
 }
 
@@ -156,65 +185,93 @@ const (
 	JoltageReadDay10MachineState   = ReadDay10MachineState(3)
 )
 
-func NewDay10MachineFromLine(line []byte) Day10Machine {
-	var endState []bool
+// NewDay10MachineFromLine parses a machine like
+// "[.##.] (3) (1,3) (2) (2,3) (0,2) (0,1) {3,5,4,7}", every button has to
+// toggle lights of the diagram
+func NewDay10MachineFromLine(line []byte) (Day10Machine, error) {
+	var endState bitset.Fixed
+	var hasEndState bool
 	var accum []byte
 	var buttonWirings [][]int
-	var buttonWiringIndex int
 	var joltageRequirements []int
 
-	addWiringButton := func() {
+	addWiringButton := func() error {
 		wiring, err := strconv.Atoi(string(accum))
 		if err != nil {
-			log.Fatalf("can't parse wiring from %q: %v", accum, err)
+			return fmt.Errorf("can't parse wiring from %q: %v", accum, err)
 		}
-		buttonWirings[buttonWiringIndex] = append(buttonWirings[buttonWiringIndex], wiring)
+		last := len(buttonWirings) - 1
+		buttonWirings[last] = append(buttonWirings[last], wiring)
 		accum = accum[0:0]
+		return nil
 	}
 
-	addJoltageRequirement := func() {
+	addJoltageRequirement := func() error {
 		joltage, err := strconv.Atoi(string(accum))
 		if err != nil {
-			log.Fatalf("can't parse joltage from %q: %v", accum, err)
+			return fmt.Errorf("can't parse joltage from %q: %v", accum, err)
 		}
 		joltageRequirements = append(joltageRequirements, joltage)
 		accum = accum[0:0]
+		return nil
 	}
 
+	// opening returns an error if b opens a section inside of another one
 	readState := UndefinedReadDay10MachineState
-	for _, b := range line {
+	opening := func(i int, b byte) error {
+		if readState != UndefinedReadDay10MachineState {
+			return fmt.Errorf("unexpected %q at %d inside of another section", b, i)
+		}
+		return nil
+	}
+	// closing returns an error if b doesn't close the section being read
+	closing := func(i int, b byte, state ReadDay10MachineState) error {
+		if readState != state {
+			return fmt.Errorf("unexpected %q at %d without its opening", b, i)
+		}
+		readState = UndefinedReadDay10MachineState
+		return nil
+	}
+
+	for i, b := range line {
+		var err error
 		switch b {
 		case '[':
+			if err = opening(i, b); err == nil && hasEndState {
+				err = fmt.Errorf("unexpected second light diagram at %d", i)
+			}
 			readState = EndStateReadDay10MachineState
 		case '(':
+			err = opening(i, b)
 			buttonWirings = append(buttonWirings, []int{})
-			buttonWiringIndex = len(buttonWirings) - 1
 			readState = WiringsReadDay10MachineState
 		case '{':
+			err = opening(i, b)
 			readState = JoltageReadDay10MachineState
 		case ']':
-			endState = make([]bool, len(accum))
-			for i, v := range accum {
-				if v == '#' {
-					endState[i] = true
-				} else {
-					endState[i] = false
-				}
+			if err = closing(i, b, EndStateReadDay10MachineState); err != nil {
+				break
+			}
+			endState, err = bitset.ParseFixed(string(accum))
+			if err != nil {
+				err = fmt.Errorf("can't parse end state from %q: %v", accum, err)
 			}
+			hasEndState = true
 			accum = accum[0:0]
-			readState = UndefinedReadDay10MachineState
 		case ')':
-			addWiringButton()
-			readState = UndefinedReadDay10MachineState
+			if err = closing(i, b, WiringsReadDay10MachineState); err == nil {
+				err = addWiringButton()
+			}
 		case '}':
-			addJoltageRequirement()
-			readState = UndefinedReadDay10MachineState
+			if err = closing(i, b, JoltageReadDay10MachineState); err == nil {
+				err = addJoltageRequirement()
+			}
 		case ',':
 			switch readState {
 			case WiringsReadDay10MachineState:
-				addWiringButton()
+				err = addWiringButton()
 			case JoltageReadDay10MachineState:
-				addJoltageRequirement()
+				err = addJoltageRequirement()
 			}
 		default:
 			switch readState {
@@ -224,21 +281,41 @@ func NewDay10MachineFromLine(line []byte) Day10Machine {
 				accum = append(accum, b)
 			}
 		}
+		if err != nil {
+			return Day10Machine{}, err
+		}
+	}
+
+	if readState != UndefinedReadDay10MachineState {
+		return Day10Machine{}, fmt.Errorf("unexpected end of line inside of a section")
+	}
+	if !hasEndState {
+		return Day10Machine{}, fmt.Errorf("missing indicator light diagram")
+	}
+
+	buttons := make([]bitset.Fixed, len(buttonWirings))
+	for i, wiring := range buttonWirings {
+		button, err := bitset.FixedFromIndices(endState.Len(), wiring...)
+		if err != nil {
+			return Day10Machine{}, fmt.Errorf("can't wire button %s: %v", day10MachineWiringString(wiring), err)
+		}
+		buttons[i] = button
 	}
 
 	return Day10Machine{
-		state:               make([]bool, len(endState)),
+		state:               bitset.NewFixed(endState.Len()),
 		endState:            endState,
 		buttonWirings:       buttonWirings,
+		buttons:             buttons,
 		joltageRequirements: joltageRequirements,
-	}
+	}, nil
 }
 
 func (m Day10Machine) String() string {
 	var buf strings.Builder
-	buf.WriteString(day10MachineStateString(m.state))
+	buf.WriteString(m.state.String())
 	buf.WriteString(" | ")
-	buf.WriteString(day10MachineStateString(m.endState))
+	buf.WriteString(m.endState.String())
 	buf.WriteString(day10MachineWiringsString(m.buttonWirings))
 	buf.WriteString(" {")
 	for i, joltage := range m.joltageRequirements {
@@ -260,20 +337,6 @@ func day10MachineWiringsString(wirings [][]int) string {
 	return buf.String()
 }
 
-func day10MachineStateString(state []bool) string {
-	var buf strings.Builder
-	buf.WriteRune('[')
-	for _, light := range state {
-		if light {
-			buf.WriteByte('#')
-		} else {
-			buf.WriteByte('.')
-		}
-	}
-	buf.WriteRune(']')
-	return buf.String()
-}
-
 func day10MachineWiringString(wiring []int) string {
 	var buf strings.Builder
 	buf.WriteRune('(')
@@ -287,31 +350,35 @@ func day10MachineWiringString(wiring []int) string {
 	return buf.String()
 }
 
-func (m Day10Machine) FindFewestButtonPresses() int {
-	initialState := make([]bool, len(m.state))
-	oldState := make([]bool, len(m.state))
-
-	// TODO: make this a bit vector so we can turn on/off things just by AND them?
-	for n := 1; n <= len(m.buttonWirings); n++ {
-		listOfWirings := combinations.Choose(n, m.buttonWirings)
-
-		for _, wirings := range listOfWirings {
-			presses := 0
-			copy(m.state, initialState)
+// day10CheckEvery is how many combinations of buttons are tried between
+// checks of the context
+const day10CheckEvery = 1024
 
-			for _, wiring := range wirings {
-				copy(oldState, m.state)
-				for _, button := range wiring {
-					m.state[button] = !m.state[button]
+// FindFewestButtonPresses tries every combination of buttons, from the
+// smallest ones, it stops if ctx is done before finding the fewest presses
+func (m Day10Machine) FindFewestButtonPresses(ctx context.Context) (int, error) {
+	tried := 0
+	for n := 1; n <= len(m.buttons); n++ {
+		for buttons := range combinations.Combinations(n, m.buttons) {
+			// there can be millions of combinations of a single size, so
+			// ctx is checked while trying them too
+			if tried%day10CheckEvery == 0 {
+				if err := ctx.Err(); err != nil {
+					return 0, err
 				}
-				presses++
+			}
+			tried++
+
+			state := m.state
+			for _, button := range buttons {
+				state = state.Xor(button)
 			}
 
-			if slices.Equal(m.state, m.endState) {
-				return presses
+			if state.Equal(m.endState) {
+				return n, nil
 			}
 		}
 	}
 
-	return len(m.buttonWirings)
+	return len(m.buttons), nil
 }
@@ -0,0 +1,174 @@
+package y2025
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/bits"
+	"math/rand/v2"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/unkiwii/aoc/lib/gen"
+)
+
+func TestDay10Part1(t *testing.T) {
+	filename := "input/day10.test"
+	want := 7
+	got := Day10Part1(filename)
+	if got != want {
+		t.Errorf("Day10Part1(%q) got %d; want: %d", filename, got, want)
+	}
+}
+
+func TestDay10Part2(t *testing.T) {
+	filename := "input/day10.test"
+	want := -1
+	got := Day10Part2(filename)
+	if got != want {
+		t.Errorf("Day10Part2(%q) got %d; want: %d", filename, got, want)
+	}
+}
+
+func TestDay10Part1Cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	filename := "input/day10.test"
+	_, err := Day10Part1Context(ctx, filename, nil)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Day10Part1Context(%q) got error %v; want: %v", filename, err, context.Canceled)
+	}
+}
+
+// day10Input writes between 1 and 10 machines of 3 to 10 lights and 2 to 8
+// buttons, with lights that some of the buttons can turn on
+func day10Input(r *rand.Rand, w io.Writer) {
+	for range 1 + r.IntN(10) {
+		lights := 3 + r.IntN(8)
+		buttons := make([]int, 2+r.IntN(7))
+		lit := 0
+		for lit == 0 {
+			for i := range buttons {
+				buttons[i] = 1 + r.IntN(1<<lights-1)
+			}
+			for _, b := range buttons {
+				if r.IntN(2) == 0 {
+					lit ^= b
+				}
+			}
+		}
+
+		fmt.Fprint(w, "[")
+		for i := range lights {
+			fmt.Fprint(w, string(".#"[lit>>i&1]))
+		}
+		fmt.Fprint(w, "]")
+		for _, b := range buttons {
+			var wiring []string
+			for i := range lights {
+				if b>>i&1 == 1 {
+					wiring = append(wiring, strconv.Itoa(i))
+				}
+			}
+			fmt.Fprintf(w, " (%s)", strings.Join(wiring, ","))
+		}
+		joltages := make([]string, lights)
+		for i := range joltages {
+			joltages[i] = strconv.Itoa(r.IntN(20))
+		}
+		fmt.Fprintf(w, " {%s}\n", strings.Join(joltages, ","))
+	}
+}
+
+// day10Reference tries every set of buttons of each machine, pushing a button
+// twice is the same as not pushing it
+func day10Reference(input string) int {
+	total := 0
+	for _, line := range strings.Split(strings.TrimSpace(input), "\n") {
+		fields := strings.Fields(line)
+
+		lit := 0
+		for i, c := range strings.Trim(fields[0], "[]") {
+			if c == '#' {
+				lit |= 1 << i
+			}
+		}
+
+		var buttons []int
+		for _, f := range fields[1 : len(fields)-1] {
+			b := 0
+			for _, light := range strings.Split(strings.Trim(f, "()"), ",") {
+				i, _ := strconv.Atoi(light)
+				b |= 1 << i
+			}
+			buttons = append(buttons, b)
+		}
+
+		fewest := len(buttons)
+		for set := range 1 << len(buttons) {
+			state := 0
+			for i, b := range buttons {
+				if set>>i&1 == 1 {
+					state ^= b
+				}
+			}
+			if state == lit {
+				fewest = min(fewest, bits.OnesCount(uint(set)))
+			}
+		}
+		total += fewest
+	}
+	return total
+}
+
+func TestDay10Part1AgainstReference(t *testing.T) {
+	gen.Check(t, 5000, day10Input, Day10Part1, day10Reference)
+}
+
+func TestNewDay10MachineFromLineErrors(t *testing.T) {
+	for _, line := range []string{
+		"",
+		"(3) (1,3) {3,5}",
+		"[.##.] 3) (1,3) {3,5}",
+		"[.##.] (3 (1,3) {3,5}",
+		"[.##.] (3) (,3) {3,5}",
+		"[.##.] (3) (1,4) {3,5}",
+		"[.##.] (3) {3,5",
+		"[.#x.] (3) {3,5}",
+		"[.##.] [.#] (1)",
+	} {
+		t.Run(fmt.Sprintf("NewDay10MachineFromLine(%q)", line), func(t *testing.T) {
+			if m, err := NewDay10MachineFromLine([]byte(line)); err == nil {
+				t.Errorf("want: error, got: %v", m)
+			}
+		})
+	}
+}
+
+func FuzzNewDay10MachineFromLine(f *testing.F) {
+	data, err := os.ReadFile("input/day10.test")
+	if err != nil {
+		f.Fatalf("can't read seeds: %v", err)
+	}
+	for _, line := range bytes.Split(data, []byte("\n")) {
+		f.Add(line)
+	}
+
+	f.Fuzz(func(t *testing.T, line []byte) {
+		m, err := NewDay10MachineFromLine(line)
+		if err != nil {
+			return
+		}
+		for i, button := range m.buttons {
+			if button.Len() != m.endState.Len() {
+				t.Errorf("NewDay10MachineFromLine(%q) got button %d of %d lights; want: %d", line, i, button.Len(), m.endState.Len())
+			}
+		}
+		_ = m.String()
+	})
+}
@@ -0,0 +1,182 @@
+package y2025
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/unkiwii/aoc/lib/gen"
+	"github.com/unkiwii/aoc/lib/trace"
+)
+
+func TestDay1Part1(t *testing.T) {
+	filename := "input/day1.test"
+	want := 3
+	got := Day1Part1(50, filename)
+	if got != want {
+		t.Errorf("Day1Part1(%q) got %d; want: %d", filename, got, want)
+	}
+}
+
+func TestDay1Part2(t *testing.T) {
+	filename := "input/day1.test"
+	want := 6
+	got := Day1Part2(50, filename)
+	if got != want {
+		t.Errorf("Day1Part2(%q) got %d; want: %d", filename, got, want)
+	}
+}
+
+// day1Trace runs solve with a JSON trace and returns the message of each step
+func day1Trace(t *testing.T, solve func(ctx context.Context) int) []string {
+	t.Helper()
+
+	var out strings.Builder
+	l, err := trace.New(&out, "json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	solve(trace.NewContext(context.Background(), l))
+
+	var msgs []string
+	s := bufio.NewScanner(strings.NewReader(out.String()))
+	for s.Scan() {
+		var step struct{ Msg string }
+		if err := json.Unmarshal(s.Bytes(), &step); err != nil {
+			t.Fatalf("can't decode step %q: %v", s.Text(), err)
+		}
+		msgs = append(msgs, step.Msg)
+	}
+	return msgs
+}
+
+func TestDay1Part1Trace(t *testing.T) {
+	filename := "input/day1.test"
+	want := []string{
+		"The dial starts by pointing at 50.",
+		"The dial is rotated L68 to point at 82.",
+		"The dial is rotated L30 to point at 52.",
+		"The dial is rotated R48 to point at 0.",
+		"The dial is rotated L5 to point at 95.",
+		"The dial is rotated R60 to point at 55.",
+		"The dial is rotated L55 to point at 0.",
+		"The dial is rotated L1 to point at 99.",
+		"The dial is rotated L99 to point at 0.",
+		"The dial is rotated R14 to point at 14.",
+		"The dial is rotated L82 to point at 32.",
+	}
+	got := day1Trace(t, func(ctx context.Context) int { return Day1Part1Context(ctx, 50, filename) })
+	if !slices.Equal(got, want) {
+		t.Errorf("Day1Part1Context(%q) traced:\n%s\nwant:\n%s", filename, strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestDay1Part2Trace(t *testing.T) {
+	filename := "input/day1.test"
+	want := []string{
+		"The dial starts by pointing at 50.",
+		"The dial is rotated L68 to point at 82; during this rotation, it points at 0 once.",
+		"The dial is rotated L30 to point at 52.",
+		"The dial is rotated R48 to point at 0.",
+		"The dial is rotated L5 to point at 95.",
+		"The dial is rotated R60 to point at 55; during this rotation, it points at 0 once.",
+		"The dial is rotated L55 to point at 0.",
+		"The dial is rotated L1 to point at 99.",
+		"The dial is rotated L99 to point at 0.",
+		"The dial is rotated R14 to point at 14.",
+		"The dial is rotated L82 to point at 32; during this rotation, it points at 0 once.",
+	}
+	got := day1Trace(t, func(ctx context.Context) int { return Day1Part2Context(ctx, 50, filename) })
+	if !slices.Equal(got, want) {
+		t.Errorf("Day1Part2Context(%q) traced:\n%s\nwant:\n%s", filename, strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+// day1Input writes between 1 and 200 rotations of up to 999 clicks
+func day1Input(r *rand.Rand, w io.Writer) {
+	for range 1 + r.IntN(200) {
+		fmt.Fprintf(w, "%c%d\n", "LR"[r.IntN(2)], 1+r.IntN(999))
+	}
+}
+
+// day1Reference turns the dial from 50 one click at a time, counting the times
+// it points at 0 at the end of a rotation and after any click
+func day1Reference(input string) (atEnd, anyClick int) {
+	pos := 50
+	for _, rotation := range strings.Fields(input) {
+		// turning left one click is the same as turning right 99 clicks
+		click := 1
+		if rotation[0] == 'L' {
+			click = 99
+		}
+		clicks, _ := strconv.Atoi(rotation[1:])
+		for range clicks {
+			pos = (pos + click) % 100
+			if pos == 0 {
+				anyClick++
+			}
+		}
+		if pos == 0 {
+			atEnd++
+		}
+	}
+	return atEnd, anyClick
+}
+
+func TestDay1Part1AgainstReference(t *testing.T) {
+	gen.Check(t, 5000, day1Input,
+		func(filename string) int { return Day1Part1(50, filename) },
+		func(input string) int {
+			atEnd, _ := day1Reference(input)
+			return atEnd
+		})
+}
+
+func TestDay1Part2AgainstReference(t *testing.T) {
+	gen.Check(t, 5000, day1Input,
+		func(filename string) int { return Day1Part2(50, filename) },
+		func(input string) int {
+			_, anyClick := day1Reference(input)
+			return anyClick
+		})
+}
+
+func FuzzDay1Rotations(f *testing.F) {
+	data, err := os.ReadFile("input/day1.test")
+	if err != nil {
+		f.Fatalf("can't read seeds: %v", err)
+	}
+	f.Add(data)
+	for _, line := range bytes.SplitAfter(data, []byte("\n")) {
+		f.Add(line)
+	}
+
+	f.Fuzz(func(t *testing.T, input []byte) {
+		r := bufio.NewReader(bytes.NewReader(input))
+		for {
+			sign, err := readSign(r)
+			if err != nil {
+				return
+			}
+			if sign != 1 && sign != -1 {
+				t.Fatalf("readSign got %d; want: 1 or -1", sign)
+			}
+			offset, err := readOffset(r)
+			if err != nil {
+				return
+			}
+			if offset < 0 {
+				t.Fatalf("readOffset got %d; want a positive offset", offset)
+			}
+		}
+	})
+}
@@ -1,12 +1,11 @@
-package main
+package y2025
 
 import (
-	"bufio"
+	"iter"
 	"log"
 	"os"
-	"strconv"
-	"strings"
 
+	"github.com/unkiwii/aoc/lib/digits"
 	"github.com/unkiwii/aoc/lib/interval"
 )
 
@@ -65,16 +64,9 @@ import (
 //
 // What do you get if you add up all of the invalid IDs?
 func Day2Part1(filename string) int {
-	return day2(filename, func(id int) bool {
-		// any invalid id is one that has the same repeated digits twice
-		s := strconv.FormatInt(int64(id), 10)
-		if len(s)%2 == 1 {
-			// ignore any odd digit number
-			return false
-		}
-		h := len(s) / 2
-		left, right := s[:h], s[h:]
-		return strings.Compare(left, right) == 0
+	// any invalid id is one that has the same repeated digits twice
+	return day2(filename, func(low, high int) iter.Seq[int] {
+		return digits.Repeated(low, high, 2)
 	})
 }
 
@@ -105,30 +97,13 @@ func Day2Part1(filename string) int {
 //
 // What do you get if you add up all of the invalid IDs using these new rules?
 func Day2Part2(filename string) int {
-	return day2(filename, func(id int) bool {
-		isInvalidParts := func(parts []string) bool {
-			for i := range parts {
-				if parts[0] != parts[i] {
-					return false
-				}
-			}
-			return len(parts) != 0
-		}
-
-		// any invalid id is one that has the same repeated digits at least twice
-		s := strconv.FormatInt(int64(id), 10)
-		half := len(s) / 2
-		for i := 1; i <= half; i++ {
-			parts := SplitN(s, i)
-			if isInvalidParts(parts) {
-				return true
-			}
-		}
-		return false
+	// any invalid id is one that has the same repeated digits at least twice
+	return day2(filename, func(low, high int) iter.Seq[int] {
+		return digits.RepeatedAtLeast(low, high, 2)
 	})
 }
 
-func day2(filename string, isInvalidID func(int) bool) int {
+func day2(filename string, invalidIDs func(low, high int) iter.Seq[int]) int {
 	file, err := os.Open(filename)
 	if err != nil {
 		log.Fatalf("can't open file %q: %v", filename, err)
@@ -136,35 +111,15 @@ func day2(filename string, isInvalidID func(int) bool) int {
 
 	result := 0
 
-	r := bufio.NewReader(file)
-	for {
-		i, isEOF := interval.Read(r, ',', []byte{'-'})
-
-		for n := range interval.Range(i) {
-			if isInvalidID(n) {
-				result += n
-			}
+	for i, err := range interval.All[int](file, ',') {
+		if err != nil {
+			log.Fatalf("can't read range from file %q: %v", filename, err)
 		}
 
-		if isEOF {
-			return result
+		for id := range invalidIDs(i.Low, i.High) {
+			result += id
 		}
 	}
-}
 
-func SplitN(s string, n int) []string {
-	if n < 1 {
-		return nil
-	}
-	var l []string
-	if len(s)%n != 0 {
-		return nil
-	}
-	left, right := 0, 0
-	for i := n; i <= len(s); i += n {
-		right = i
-		l = append(l, s[left:right])
-		left = right
-	}
-	return l
+	return result
 }
@@ -0,0 +1,82 @@
+package y2025
+
+import (
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/unkiwii/aoc/lib/gen"
+)
+
+func TestDay2Part1(t *testing.T) {
+	filename := "input/day2.test"
+	want := 1227775554
+	got := Day2Part1(filename)
+	if got != want {
+		t.Errorf("Day2Part1(%q) got %d; want: %d", filename, got, want)
+	}
+}
+
+func TestDay2Part2(t *testing.T) {
+	filename := "input/day2.test"
+	want := 4174379265
+	got := Day2Part2(filename)
+	if got != want {
+		t.Errorf("Day2Part2(%q) got %d; want: %d", filename, got, want)
+	}
+}
+
+// day2Input writes between 1 and 10 ranges of ids of up to 10 digits, half of
+// them around an id made of repeated digits so they have some invalid ones
+func day2Input(r *rand.Rand, w io.Writer) {
+	ranges := make([]string, 1+r.IntN(10))
+	for i := range ranges {
+		var id int
+		if r.IntN(2) == 0 {
+			block := strconv.Itoa(1 + r.IntN(999))
+			id, _ = strconv.Atoi(strings.Repeat(block, 1+r.IntN(10/len(block))))
+		} else {
+			id = 1 + r.IntN(9_999_999_999)
+		}
+		low := max(1, id-r.IntN(500))
+		ranges[i] = fmt.Sprintf("%d-%d", low, id+r.IntN(500))
+	}
+	fmt.Fprintln(w, strings.Join(ranges, ","))
+}
+
+// day2Reference sums every id of the ranges made of a sequence of digits
+// repeated a number of times accepted by times
+func day2Reference(input string, times func(n int) bool) int {
+	result := 0
+	for _, r := range strings.Split(strings.TrimSpace(input), ",") {
+		low, high, _ := strings.Cut(r, "-")
+		first, _ := strconv.Atoi(low)
+		last, _ := strconv.Atoi(high)
+		for id := first; id <= last; id++ {
+			s := strconv.Itoa(id)
+			for size := 1; size <= len(s)/2; size++ {
+				n := len(s) / size
+				if len(s)%size == 0 && times(n) && strings.Repeat(s[:size], n) == s {
+					result += id
+					break
+				}
+			}
+		}
+	}
+	return result
+}
+
+func TestDay2Part1AgainstReference(t *testing.T) {
+	gen.Check(t, 3000, day2Input, Day2Part1, func(input string) int {
+		return day2Reference(input, func(n int) bool { return n == 2 })
+	})
+}
+
+func TestDay2Part2AgainstReference(t *testing.T) {
+	gen.Check(t, 3000, day2Input, Day2Part2, func(input string) int {
+		return day2Reference(input, func(n int) bool { return n >= 2 })
+	})
+}
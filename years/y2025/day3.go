@@ -1,12 +1,12 @@
-package main
+package y2025
 
 import (
 	"bufio"
 	"io"
 	"log"
 	"os"
-	"strconv"
 
+	"github.com/unkiwii/aoc/lib/digits"
 	"github.com/unkiwii/aoc/lib/stack"
 )
 
@@ -126,30 +126,12 @@ func day3(filename string, numberOfDigits int) int {
 			log.Fatalf("can't read bank: %v", err)
 		}
 
-		stack := stack.New[byte]()
-		stack.Push(bank[0])
-
-		maxJoltage := 0
+		batteries := make([]int, len(bank))
 		for i, c := range bank {
-			if i == 0 {
-				continue
-			}
-			for c > stack.Top() && stack.Len() > 0 && numberOfDigits-stack.Len() < len(bank)-i {
-				stack.Pop()
-			}
-			stack.Push(c)
-
-			if stack.Len() == numberOfDigits {
-				joltage, err := strconv.Atoi(string(stack.Slice()))
-				if err != nil {
-					panic(err)
-				}
-				if joltage > maxJoltage {
-					maxJoltage = joltage
-				}
-			}
+			batteries[i] = int(c - '0')
 		}
 
+		maxJoltage := digits.FromDigits(stack.MaxSubsequence(batteries, numberOfDigits))
 		result += maxJoltage
 	}
 }
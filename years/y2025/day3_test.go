@@ -0,0 +1,70 @@
+package y2025
+
+import (
+	"io"
+	"math/rand/v2"
+	"strings"
+	"testing"
+
+	"github.com/unkiwii/aoc/lib/gen"
+)
+
+func TestDay3Part1(t *testing.T) {
+	filename := "input/day3.test"
+	want := 357
+	got := Day3Part1(filename)
+	if got != want {
+		t.Errorf("Day3Part1(%q) got %d; want: %d", filename, got, want)
+	}
+}
+
+func TestDay3Part2(t *testing.T) {
+	filename := "input/day3.test"
+	want := 3121910778619
+	got := Day3Part2(filename)
+	if got != want {
+		t.Errorf("Day3Part2(%q) got %d; want: %d", filename, got, want)
+	}
+}
+
+// day3Input writes between 1 and 20 banks of 12 to 16 batteries
+func day3Input(r *rand.Rand, w io.Writer) {
+	for range 1 + r.IntN(20) {
+		bank := make([]byte, 12+r.IntN(5))
+		for i := range bank {
+			bank[i] = byte('1' + r.IntN(9))
+		}
+		w.Write(append(bank, '\n'))
+	}
+}
+
+// day3Reference tries every way to turn on n batteries of each bank
+func day3Reference(input string, n int) int {
+	result := 0
+	for _, bank := range strings.Fields(input) {
+		best := 0
+		var try func(i, left, joltage int)
+		try = func(i, left, joltage int) {
+			if left == 0 {
+				best = max(best, joltage)
+				return
+			}
+			if len(bank)-i < left {
+				return
+			}
+			try(i+1, left-1, joltage*10+int(bank[i]-'0'))
+			try(i+1, left, joltage)
+		}
+		try(0, n, 0)
+		result += best
+	}
+	return result
+}
+
+func TestDay3Part1AgainstReference(t *testing.T) {
+	gen.Check(t, 3000, day3Input, Day3Part1, func(input string) int { return day3Reference(input, 2) })
+}
+
+func TestDay3Part2AgainstReference(t *testing.T) {
+	gen.Check(t, 3000, day3Input, Day3Part2, func(input string) int { return day3Reference(input, 12) })
+}
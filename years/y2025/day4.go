@@ -1,11 +1,17 @@
-package main
+package y2025
 
 import (
 	"bufio"
+	"context"
 	"fmt"
+	"image/color"
 	"io"
 	"log"
 	"os"
+	"time"
+
+	"github.com/unkiwii/aoc/lib/render"
+	"github.com/unkiwii/aoc/lib/runner"
 )
 
 // --- Day 4: Printing Department ---
@@ -210,17 +216,49 @@ func Day4Part1(filename string) int {
 // Start with your original diagram. How many rolls of paper in total can be
 // removed by the Elves and their forklifts?
 func Day4Part2(filename string) int {
-	grid := NewDay4GridFromFile(filename)
+	return day4Part2(NewDay4GridFromFile(filename), nil)
+}
+
+// Day4Part2Context is Day4Part2 but it draws every step with the Drawer of
+// ctx, if it has one
+func Day4Part2Context(ctx context.Context, filename string, _ *runner.Progress) (int, error) {
+	return day4Part2(NewDay4GridFromFile(filename), render.FromContext(ctx).Draw), nil
+}
+
+// Day4Palette draws the rolls of paper in gray, the ones a forklift can access
+// in red and the empty spaces in white
+var Day4Palette = render.Palette{
+	'.': color.White,
+	'@': color.Gray{Y: 0x60},
+	'x': color.RGBA{R: 0xd0, G: 0x20, B: 0x20, A: 0xff},
+}
+
+// Day4Part2GIF writes to w an animation of the rolls of paper being marked and
+// removed until no more rolls can be accessed
+func Day4Part2GIF(filename string, w io.Writer) error {
+	recorder := render.NewRecorder(Day4Palette, 4, 300*time.Millisecond)
+	day4Part2(NewDay4GridFromFile(filename), recorder.Record)
+	return recorder.WriteGIF(w)
+}
+
+// day4Part2 removes every roll it can, calling record, if not nil, with the
+// grid before and after every roll that can be accessed is marked
+func day4Part2(grid Day4Grid, record func(render.Grid)) int {
+	if record == nil {
+		record = func(render.Grid) {}
+	}
 
 	step := 0
 	result := 0
 
 	for {
 		step++
+		record(grid)
 		m := grid.Mark()
 		if m == 0 {
 			return result
 		}
+		record(grid)
 		s := grid.Sweep()
 		if m != s {
 			log.Fatalf("marked %d rolls, but sweeped %d\n", m, s)
@@ -276,6 +314,23 @@ func (g Day4Grid) Show() {
 	fmt.Println()
 }
 
+// Size returns the size of the grid, so it can be rendered
+func (g Day4Grid) Size() (width, height int) {
+	if len(g) == 0 {
+		return 0, 0
+	}
+	return len(g[0]), len(g)
+}
+
+// At returns the value at x, y like ValueAt, but the rolls that are free to be
+// removed are shown as 'x', like Show does
+func (g Day4Grid) At(x, y int) byte {
+	if v := g.ValueAt(x, y); v == 0 || !g[y][x].free {
+		return v
+	}
+	return 'x'
+}
+
 func (g Day4Grid) Mark() int {
 	count := 0
 	for y := range len(g) {
@@ -0,0 +1,122 @@
+package y2025
+
+import (
+	"bytes"
+	"image/gif"
+	"io"
+	"math/rand/v2"
+	"strings"
+	"testing"
+
+	"github.com/unkiwii/aoc/lib/gen"
+	"github.com/unkiwii/aoc/lib/golden"
+)
+
+func TestDay4Part1(t *testing.T) {
+	filename := "input/day4.test"
+	want := 13
+	got := Day4Part1(filename)
+	if got != want {
+		t.Errorf("Day4Part1(%q) got %d; want: %d", filename, got, want)
+	}
+}
+
+// TestDay4Part1Grid compares the rolls a forklift can access, marked with x,
+// with the example
+func TestDay4Part1Grid(t *testing.T) {
+	grid := NewDay4GridFromFile("input/day4.test")
+	grid.Mark()
+	golden.Grid(t, "input/day4_want.test", grid)
+}
+
+func TestDay4Part2(t *testing.T) {
+	filename := "input/day4.test"
+	want := 43
+	got := Day4Part2(filename)
+	if got != want {
+		t.Errorf("Day4Part2(%q) got %d; want: %d", filename, got, want)
+	}
+}
+
+func TestDay4Part2GIF(t *testing.T) {
+	filename := "input/day4.test"
+	var buf bytes.Buffer
+	if err := Day4Part2GIF(filename, &buf); err != nil {
+		t.Fatalf("Day4Part2GIF(%q) failed: %v", filename, err)
+	}
+
+	anim, err := gif.DecodeAll(&buf)
+	if err != nil {
+		t.Fatalf("Day4Part2GIF(%q) wrote an invalid GIF: %v", filename, err)
+	}
+	// a frame before and after marking on each step, and the last one
+	if got := len(anim.Image); got < 3 || got%2 != 1 {
+		t.Errorf("Day4Part2GIF(%q) got %d frames; want an odd amount", filename, got)
+	}
+}
+
+// day4Input writes a grid of up to 20 by 20 with a random density of rolls
+func day4Input(r *rand.Rand, w io.Writer) {
+	width, height := 1+r.IntN(20), 1+r.IntN(20)
+	density := r.Float64()
+	for range height {
+		row := make([]byte, width)
+		for x := range row {
+			row[x] = '.'
+			if r.Float64() < density {
+				row[x] = '@'
+			}
+		}
+		w.Write(append(row, '\n'))
+	}
+}
+
+// day4Reference counts the rolls with less than four rolls around them, if
+// remove is true it removes each one as soon as it's found and starts over
+// until there is none left, removing rolls only makes more of them accessible
+// so the order doesn't change the total
+func day4Reference(input string, remove bool) int {
+	var grid [][]byte
+	for _, row := range strings.Fields(input) {
+		grid = append(grid, []byte(row))
+	}
+	accessible := func(x, y int) bool {
+		rolls := 0
+		for dy := -1; dy <= 1; dy++ {
+			for dx := -1; dx <= 1; dx++ {
+				nx, ny := x+dx, y+dy
+				if (dx != 0 || dy != 0) && ny >= 0 && ny < len(grid) && nx >= 0 && nx < len(grid[ny]) && grid[ny][nx] == '@' {
+					rolls++
+				}
+			}
+		}
+		return rolls < 4
+	}
+
+	result := 0
+	for {
+		found := 0
+		for y := range grid {
+			for x := range grid[y] {
+				if grid[y][x] == '@' && accessible(x, y) {
+					found++
+					if remove {
+						grid[y][x] = '.'
+					}
+				}
+			}
+		}
+		result += found
+		if !remove || found == 0 {
+			return result
+		}
+	}
+}
+
+func TestDay4Part1AgainstReference(t *testing.T) {
+	gen.Check(t, 5000, day4Input, Day4Part1, func(input string) int { return day4Reference(input, false) })
+}
+
+func TestDay4Part2AgainstReference(t *testing.T) {
+	gen.Check(t, 5000, day4Input, Day4Part2, func(input string) int { return day4Reference(input, true) })
+}
@@ -1,4 +1,4 @@
-package main
+package y2025
 
 import (
 	"bufio"
@@ -8,7 +8,6 @@ import (
 	"strconv"
 
 	"github.com/unkiwii/aoc/lib/interval"
-	"github.com/unkiwii/aoc/lib/list"
 )
 
 // --- Day 5: Cafeteria ---
@@ -64,10 +63,11 @@ import (
 // of the available ingredient IDs are fresh?
 func Day5Part1(filename string) int {
 	freshIntervals, ingredients := readDatabase(filename, false)
+	fresh := interval.NewSet(freshIntervals...)
 
 	count := 0
 	for _, in := range ingredients {
-		if isFresh(freshIntervals, in) {
+		if fresh.Contains(in) {
 			count++
 		}
 	}
@@ -100,88 +100,44 @@ func Day5Part1(filename string) int {
 // be fresh according to the fresh ingredient ID ranges?
 func Day5Part2(filename string) int {
 	freshIntervals, _ := readDatabase(filename, true)
-
-	mergeIntervals := func(intervals *list.List[interval.Interval]) int {
-		amountMerged := 0
-
-		for a := intervals; a != nil; a = a.Next {
-			for b := a.Next; b != nil; b = b.Next {
-				if b.Value.IsInside(a.Value) {
-					a.Next = b.Next
-				} else if a.Value.Overlaps(b.Value) {
-					a.Value = a.Value.Merge(b.Value)
-					amountMerged++
-				}
-			}
-		}
-
-		return amountMerged
-	}
-
-	intervals := list.FromSlice(freshIntervals)
-	for amountMerged := -1; amountMerged != 0; {
-		amountMerged = mergeIntervals(intervals)
-	}
-
-	count := 0
-
-	for i := intervals; i != nil; i = i.Next {
-		count += i.Value.Distance()
-	}
-
-	return count
+	return interval.NewSet(freshIntervals...).TotalLength()
 }
 
-func readDatabase(filename string, stopAtIntervals bool) ([]interval.Interval, []int) {
+func readDatabase(filename string, stopAtIntervals bool) ([]interval.Interval[int], []int) {
 	file, err := os.Open(filename)
 	if err != nil {
 		log.Fatalf("can't open file %q: %v", filename, err)
 	}
 
-	readingFreshIntervals := true
-	var freshIntervals []interval.Interval
+	var freshIntervals []interval.Interval[int]
 	var ingredients []int
 
 	r := bufio.NewReader(file)
-	for {
-		if readingFreshIntervals {
-			i, eof := interval.Read(r, '\n', []byte("-"))
-			if eof {
-				log.Fatal("unexpected EOF")
-			}
-			freshIntervals = append(freshIntervals, i)
 
-			next, _ := r.Peek(1)
-			if next[0] == '\n' {
-				r.Discard(1) // discard empty line
-				readingFreshIntervals = false
-				if stopAtIntervals {
-					return freshIntervals, ingredients
-				}
-			}
-
-		} else {
-			line, _, err := r.ReadLine()
-			if err == io.EOF {
-				return freshIntervals, ingredients
-			}
-			if err != nil {
-				log.Fatalf("can't read line: %v", err)
-			}
-			n, err := strconv.Atoi(string(line))
-			if err != nil {
-				log.Fatalf("can't parse ingredient: %v", err)
-			}
-			ingredients = append(ingredients, n)
-		}
+	// the fresh intervals end with an empty line
+	scanner := interval.NewScanner[int](r, '\n')
+	for scanner.Scan() {
+		freshIntervals = append(freshIntervals, scanner.Interval())
+	}
+	if err := scanner.Err(); err != nil {
+		log.Fatalf("can't read fresh interval from file %q: %v", filename, err)
+	}
+	if stopAtIntervals {
+		return freshIntervals, ingredients
 	}
-}
 
-func isFresh(freshIntervals []interval.Interval, ingredient int) bool {
-	for _, in := range freshIntervals {
-		if in.Contains(ingredient) {
-			return true
+	for {
+		line, _, err := r.ReadLine()
+		if err == io.EOF {
+			return freshIntervals, ingredients
+		}
+		if err != nil {
+			log.Fatalf("can't read line: %v", err)
+		}
+		n, err := strconv.Atoi(string(line))
+		if err != nil {
+			log.Fatalf("can't parse ingredient: %v", err)
 		}
+		ingredients = append(ingredients, n)
 	}
-	return false
 }
@@ -0,0 +1,82 @@
+package y2025
+
+import (
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/unkiwii/aoc/lib/gen"
+)
+
+func TestDay5Part1(t *testing.T) {
+	filename := "input/day5.test"
+	want := 3
+	got := Day5Part1(filename)
+	if got != want {
+		t.Errorf("Day5Part1(%q) got %d; want: %d", filename, got, want)
+	}
+}
+
+func TestDay5Part2(t *testing.T) {
+	filename := "input/day5.test"
+	want := 14
+	got := Day5Part2(filename)
+	if got != want {
+		t.Errorf("Day5Part2(%q) got %d; want: %d", filename, got, want)
+	}
+}
+
+// day5Input writes between 1 and 20 ranges of fresh ids, that may overlap,
+// and between 1 and 30 ids of ingredients
+func day5Input(r *rand.Rand, w io.Writer) {
+	for range 1 + r.IntN(20) {
+		low := r.IntN(1000)
+		fmt.Fprintf(w, "%d-%d\n", low, low+r.IntN(100))
+	}
+	fmt.Fprintln(w)
+	for range 1 + r.IntN(30) {
+		fmt.Fprintln(w, r.IntN(1100))
+	}
+}
+
+// day5Reference returns every fresh id and the ids of the ingredients
+func day5Reference(input string) (fresh map[int]bool, ingredients []int) {
+	ranges, ids, _ := strings.Cut(input, "\n\n")
+	fresh = map[int]bool{}
+	for _, r := range strings.Fields(ranges) {
+		low, high, _ := strings.Cut(r, "-")
+		first, _ := strconv.Atoi(low)
+		last, _ := strconv.Atoi(high)
+		for id := first; id <= last; id++ {
+			fresh[id] = true
+		}
+	}
+	for _, id := range strings.Fields(ids) {
+		n, _ := strconv.Atoi(id)
+		ingredients = append(ingredients, n)
+	}
+	return fresh, ingredients
+}
+
+func TestDay5Part1AgainstReference(t *testing.T) {
+	gen.Check(t, 5000, day5Input, Day5Part1, func(input string) int {
+		fresh, ingredients := day5Reference(input)
+		count := 0
+		for _, id := range ingredients {
+			if fresh[id] {
+				count++
+			}
+		}
+		return count
+	})
+}
+
+func TestDay5Part2AgainstReference(t *testing.T) {
+	gen.Check(t, 5000, day5Input, Day5Part2, func(input string) int {
+		fresh, _ := day5Reference(input)
+		return len(fresh)
+	})
+}
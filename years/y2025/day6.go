@@ -1,4 +1,4 @@
-package main
+package y2025
 
 import (
 	"bufio"
@@ -0,0 +1,140 @@
+package y2025
+
+import (
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/unkiwii/aoc/lib/gen"
+)
+
+func TestDay6Part1(t *testing.T) {
+	filename := "input/day6.test"
+	want := 4277556
+	got := Day6Part1(filename)
+	if got != want {
+		t.Errorf("Day6Part1(%q) got %d; want: %d", filename, got, want)
+	}
+}
+
+func TestDay6Part2(t *testing.T) {
+	filename := "input/day6.test"
+	want := 3263827
+	got := Day6Part2(filename)
+	if got != want {
+		t.Errorf("Day6Part2(%q) got %d; want: %d", filename, got, want)
+	}
+}
+
+// day6Input writes between 1 and 10 problems of 2 to 4 numbers, the numbers
+// of each problem aligned to the left or to the right of its columns, and
+// every line as long as the others
+func day6Input(r *rand.Rand, w io.Writer) {
+	rows := 2 + r.IntN(3)
+	lines := make([]string, rows+1)
+	for p := range 1 + r.IntN(10) {
+		numbers := make([]string, rows)
+		width := 0
+		for i := range numbers {
+			numbers[i] = strconv.Itoa(1 + r.IntN(999))
+			width = max(width, len(numbers[i]))
+		}
+		// a column of spaces separates the problems
+		if p > 0 {
+			for i := range lines {
+				lines[i] += " "
+			}
+		}
+		format := "%*s"
+		if r.IntN(2) == 0 {
+			format = "%-*s"
+		}
+		for i, n := range numbers {
+			lines[i] += fmt.Sprintf(format, width, n)
+		}
+		lines[rows] += fmt.Sprintf("%-*c", width, "+*"[r.IntN(2)])
+	}
+	fmt.Fprintln(w, strings.Join(lines, "\n"))
+}
+
+// day6Reference splits the worksheet in problems by its columns of spaces
+// and solves each one with the numbers read by numbers from its rows
+func day6Reference(input string, numbers func(rows []string) []int) int {
+	lines := strings.Split(strings.TrimSuffix(input, "\n"), "\n")
+	operators := lines[len(lines)-1]
+	rows := lines[:len(lines)-1]
+
+	total := 0
+	solve := func(first, last int) {
+		var block []string
+		for _, row := range rows {
+			block = append(block, row[first:last])
+		}
+		multiply := strings.TrimSpace(operators[first:last]) == "*"
+		result := 0
+		if multiply {
+			result = 1
+		}
+		for _, n := range numbers(block) {
+			if multiply {
+				result *= n
+			} else {
+				result += n
+			}
+		}
+		total += result
+	}
+
+	first := 0
+	for x := range len(operators) + 1 {
+		blank := x == len(operators)
+		if !blank {
+			blank = operators[x] == ' '
+			for _, row := range rows {
+				blank = blank && row[x] == ' '
+			}
+		}
+		if blank {
+			solve(first, x)
+			first = x + 1
+		}
+	}
+	return total
+}
+
+func TestDay6Part1AgainstReference(t *testing.T) {
+	gen.Check(t, 5000, day6Input, Day6Part1, func(input string) int {
+		// each row is a number
+		return day6Reference(input, func(rows []string) []int {
+			var numbers []int
+			for _, row := range rows {
+				n, _ := strconv.Atoi(strings.TrimSpace(row))
+				numbers = append(numbers, n)
+			}
+			return numbers
+		})
+	})
+}
+
+func TestDay6Part2AgainstReference(t *testing.T) {
+	gen.Check(t, 5000, day6Input, Day6Part2, func(input string) int {
+		// each column is a number, with its digits from top to bottom
+		return day6Reference(input, func(rows []string) []int {
+			var numbers []int
+			for x := range len(rows[0]) {
+				var digits []byte
+				for _, row := range rows {
+					if row[x] != ' ' {
+						digits = append(digits, row[x])
+					}
+				}
+				n, _ := strconv.Atoi(string(digits))
+				numbers = append(numbers, n)
+			}
+			return numbers
+		})
+	})
+}
@@ -1,13 +1,18 @@
-package main
+package y2025
 
 import (
 	"bufio"
+	"context"
 	"fmt"
+	"image/color"
 	"io"
 	"log"
 	"os"
+	"time"
 
-	"github.com/unkiwii/aoc/lib/stack"
+	"github.com/unkiwii/aoc/lib/deque"
+	"github.com/unkiwii/aoc/lib/render"
+	"github.com/unkiwii/aoc/lib/runner"
 )
 
 // --- Day 7: Laboratories ---
@@ -158,39 +163,71 @@ import (
 //
 // Analyze your manifold diagram. How many times will the beam be split?
 func Day7Part1(filename string) int {
-	grid := NewDay7GridFromFile(filename)
-	// grid.Show()
+	return day7Part1(NewDay7GridFromFile(filename), nil)
+}
+
+// Day7Part1Context is Day7Part1 but it draws the grid every time a beam ends
+// with the Drawer of ctx, if it has one
+func Day7Part1Context(ctx context.Context, filename string, _ *runner.Progress) (int, error) {
+	return day7Part1(NewDay7GridFromFile(filename), render.FromContext(ctx).Draw), nil
+}
+
+// Day7Palette draws the manifold in black, the splitters in yellow, the
+// start in green and the beams in red
+var Day7Palette = render.Palette{
+	'.': color.Black,
+	'^': color.RGBA{R: 0xf0, G: 0xd0, B: 0x20, A: 0xff},
+	'S': color.RGBA{R: 0x20, G: 0xd0, B: 0x20, A: 0xff},
+	'|': color.RGBA{R: 0xe0, G: 0x20, B: 0x20, A: 0xff},
+}
+
+// Day7Part1GIF writes to w an animation of the beams going through the
+// manifold, with a frame every time a beam ends
+func Day7Part1GIF(filename string, w io.Writer) error {
+	recorder := render.NewRecorder(Day7Palette, 4, 50*time.Millisecond)
+	day7Part1(NewDay7GridFromFile(filename), recorder.Record)
+	return recorder.WriteGIF(w)
+}
 
-	stack := stack.New[Laser]()
-	stack.Push(NewLaser(grid.StartX, grid.StartY+1))
+// day7Part1 follows every beam, calling record, if not nil, with the grid
+// before the first beam and after each beam ends
+func day7Part1(grid Day7Grid, record func(render.Grid)) int {
+	if record == nil {
+		record = func(render.Grid) {}
+	}
+	record(grid)
+
+	// the beams are followed in the order they are split, a breadth first flood
+	queue := deque.New[Laser]()
+	queue.PushBack(NewLaser(grid.StartX, grid.StartY+1))
 
 	result := 0
 
 loop:
-	for stack.Len() > 0 {
+	for !queue.IsEmpty() {
 		// grid.Show()
 
-		l := stack.Pop()
+		l, _ := queue.PopFront()
 
 		var s LaserState
 		for s = LaserStateContinue; s == LaserStateContinue; s = l.Advance(grid) {
 		}
+		record(grid)
 
 		switch s {
 		case LaserStateEnd:
 			continue loop
 		case LaserStateDuplicate:
-			split := false
+			// a splitter is only reached once, the lasers that come after
+			// find the beam above it and end there, so it always counts
+			// even if other beams already went down both of its sides,
+			// see input/day7_split.test
+			result++
 			if grid.Read(l.X+1, l.Y) == '.' {
-				split = true
-				stack.Push(NewLaser(l.X+1, l.Y))
+				queue.PushBack(NewLaser(l.X+1, l.Y))
 			}
 			if grid.Read(l.X-1, l.Y) == '.' {
-				split = true
-				stack.Push(NewLaser(l.X-1, l.Y))
-			}
-			if split {
-				result++
+				queue.PushBack(NewLaser(l.X-1, l.Y))
 			}
 		case LaserStateContinue:
 			log.Fatalf("ERROR: unpexpected LaserStateContinue")
@@ -351,6 +388,19 @@ func (grid Day7Grid) Show() {
 	fmt.Println()
 }
 
+// Size returns the size of the grid, so it can be rendered
+func (grid Day7Grid) Size() (width, height int) {
+	if len(grid.cells) == 0 {
+		return 0, 0
+	}
+	return len(grid.cells[0]), grid.Height
+}
+
+// At is the same as Read, so the grid can be rendered
+func (grid Day7Grid) At(x, y int) byte {
+	return grid.Read(x, y)
+}
+
 func (grid Day7Grid) Write(x, y int, value byte) byte {
 	if y < 0 || y >= len(grid.cells) || x < 0 || x >= len(grid.cells[y]) {
 		return 0
@@ -0,0 +1,116 @@
+package y2025
+
+import (
+	"bytes"
+	"image/gif"
+	"io"
+	"math/rand/v2"
+	"strings"
+	"testing"
+
+	"github.com/unkiwii/aoc/lib/gen"
+	"github.com/unkiwii/aoc/lib/golden"
+)
+
+func TestDay7Part1(t *testing.T) {
+	filename := "input/day7.test"
+	want := 21
+	got := Day7Part1(filename)
+	if got != want {
+		t.Errorf("Day7Part1(%q) got %d; want: %d", filename, got, want)
+	}
+}
+
+// TestDay7Part1BothSidesLit has a splitter whose sides are lit by other beams
+// before its beam reaches it, which still counts as a split
+func TestDay7Part1BothSidesLit(t *testing.T) {
+	filename := "input/day7_split.test"
+	want := 5
+	got := Day7Part1(filename)
+	if got != want {
+		t.Errorf("Day7Part1(%q) got %d; want: %d", filename, got, want)
+	}
+}
+
+// TestDay7Part1Grid compares the beams that went through the manifold with the
+// example
+func TestDay7Part1Grid(t *testing.T) {
+	grid := NewDay7GridFromFile("input/day7.test")
+	day7Part1(grid, nil)
+	golden.Grid(t, "input/day7_want.test", grid)
+}
+
+// func TestDay7Part2(t *testing.T) {
+// 	filename := "input/day7.test"
+// 	want := 40
+// 	got := Day7Part2(filename)
+// 	if got != want {
+// 		t.Errorf("Day7Part2(%q) got %d; want: %d", filename, got, want)
+// 	}
+// }
+
+func TestDay7Part1GIF(t *testing.T) {
+	filename := "input/day7.test"
+	var buf bytes.Buffer
+	if err := Day7Part1GIF(filename, &buf); err != nil {
+		t.Fatalf("Day7Part1GIF(%q) failed: %v", filename, err)
+	}
+
+	anim, err := gif.DecodeAll(&buf)
+	if err != nil {
+		t.Fatalf("Day7Part1GIF(%q) wrote an invalid GIF: %v", filename, err)
+	}
+	if got := anim.Image[0].Bounds().Size(); got.X != 15*4 || got.Y != 16*4 {
+		t.Errorf("Day7Part1GIF(%q) got frames of %v; want: (60,64)", filename, got)
+	}
+}
+
+// day7Input writes a manifold up to 25 wide with S in the middle of the first
+// row and splitters on every other row, never next to each other nor on the
+// edges
+func day7Input(r *rand.Rand, w io.Writer) {
+	width := 3 + r.IntN(23)
+	density := r.Float64()
+
+	start := []byte(strings.Repeat(".", width))
+	start[width/2] = 'S'
+	w.Write(append(start, '\n'))
+	for range 1 + r.IntN(10) {
+		io.WriteString(w, strings.Repeat(".", width)+"\n")
+
+		row := []byte(strings.Repeat(".", width))
+		for x := 1; x < width-1; x++ {
+			if row[x-1] != '^' && r.Float64() < density {
+				row[x] = '^'
+			}
+		}
+		w.Write(append(row, '\n'))
+	}
+	io.WriteString(w, strings.Repeat(".", width)+"\n")
+}
+
+// day7Reference moves every beam one row at a time, the beams that reach the
+// same place are the same beam
+func day7Reference(input string) int {
+	rows := strings.Fields(input)
+	beams := map[int]bool{strings.IndexByte(rows[0], 'S'): true}
+	splits := 0
+	for _, row := range rows[1:] {
+		next := map[int]bool{}
+		for x := range beams {
+			if row[x] != '^' {
+				next[x] = true
+				continue
+			}
+			splits++
+			next[x-1] = true
+			next[x+1] = true
+		}
+		beams = next
+	}
+	return splits
+}
+
+func TestDay7Part1AgainstReference(t *testing.T) {
+	gen.Check(t, 5000, day7Input, Day7Part1, day7Reference)
+}
@@ -1,4 +1,4 @@
-package main
+package y2025
 
 import (
 	"bufio"
@@ -10,6 +10,7 @@ import (
 	"strconv"
 
 	"github.com/unkiwii/aoc/lib/heap"
+	"github.com/unkiwii/aoc/lib/render"
 )
 
 // --- Day 8: Playground ---
@@ -99,35 +100,9 @@ import (
 // junction boxes which are closest together. Afterward, what do you get if you
 // multiply together the sizes of the three largest circuits?
 func Day8Part1(maxConnections int, filename string) int {
-	file, err := os.Open(filename)
-	if err != nil {
-		log.Fatalf("can't open file %q: %v", filename, err)
-	}
+	points := readDay8Points(filename)
 
-	var points []Point3D
-
-	r := bufio.NewReader(file)
-	for {
-		line, _, err := r.ReadLine()
-		if err == io.EOF {
-			break
-		}
-		if err != nil {
-			log.Fatalf("can't read line from file %q: %v", filename, err)
-		}
-
-		points = append(points, NewPoint3DFromLine(line))
-	}
-
-	distances := heap.NewWithLess(PointPairLess)
-	for i := 0; i < len(points)-1; i++ {
-		a := points[i]
-		for j := i + 1; j < len(points); j++ {
-			b := points[j]
-			pair := NewPointPair(a, b)
-			distances.PushItem(pair)
-		}
-	}
+	distances := day8Distances(points)
 
 	circuits := map[Point3D][]Point3D{}
 
@@ -176,27 +151,23 @@ mainLoop:
 		}
 	}
 
-	circuitSizes := map[int]int{}
-	for _, circuit := range circuits {
-		size := len(circuit)
-		circuitSizes[size]++
-	}
-
-	maxSizeOfCircuits := func() int {
-		maxSize := 0
-		for size := range circuitSizes {
-			if size > maxSize {
-				maxSize = size
-			}
+	// every point of a circuit maps to the same circuit, so each circuit is
+	// counted only once, but circuits with the same size are all counted
+	largest := heap.TopK[int](3)
+	counted := map[Point3D]bool{}
+	for p, circuit := range circuits {
+		if counted[p] {
+			continue
 		}
-		return maxSize
+		for _, q := range circuit {
+			counted[q] = true
+		}
+		largest.Push(len(circuit))
 	}
 
 	result := 1
-	for range 3 {
-		size := maxSizeOfCircuits()
+	for _, size := range largest.Slice() {
 		result *= size
-		delete(circuitSizes, size)
 	}
 
 	return result
@@ -220,35 +191,9 @@ mainLoop:
 // together the X coordinates of the last two junction boxes you need to
 // connect?
 func Day8Part2(filename string) int {
-	file, err := os.Open(filename)
-	if err != nil {
-		log.Fatalf("can't open file %q: %v", filename, err)
-	}
-
-	var points []Point3D
-
-	r := bufio.NewReader(file)
-	for {
-		line, _, err := r.ReadLine()
-		if err == io.EOF {
-			break
-		}
-		if err != nil {
-			log.Fatalf("can't read line from file %q: %v", filename, err)
-		}
-
-		points = append(points, NewPoint3DFromLine(line))
-	}
+	points := readDay8Points(filename)
 
-	distances := heap.NewWithLess(PointPairLess)
-	for i := 0; i < len(points)-1; i++ {
-		a := points[i]
-		for j := i + 1; j < len(points); j++ {
-			b := points[j]
-			pair := NewPointPair(a, b)
-			distances.PushItem(pair)
-		}
-	}
+	distances := day8Distances(points)
 
 	circuits := map[Point3D][]Point3D{}
 
@@ -308,26 +253,127 @@ type Point3D struct {
 	X, Y, Z int
 }
 
-func NewPoint3DFromLine(line []byte) Point3D {
+func readDay8Points(filename string) []Point3D {
+	file, err := os.Open(filename)
+	if err != nil {
+		log.Fatalf("can't open file %q: %v", filename, err)
+	}
+
+	var points []Point3D
+
+	r := bufio.NewReader(file)
+	for {
+		line, _, err := r.ReadLine()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			log.Fatalf("can't read line from file %q: %v", filename, err)
+		}
+
+		point, err := NewPoint3DFromLine(line)
+		if err != nil {
+			log.Fatalf("can't read point from file %q: %v", filename, err)
+		}
+		points = append(points, point)
+	}
+
+	return points
+}
+
+// day8SpanningTree returns the pairs of junction boxes that connect every box
+// in a single circuit with the least cable, joining the closest boxes that are
+// not in the same circuit yet
+func day8SpanningTree(points []Point3D) []PointPair {
+	// each box points to another one of its circuit, until the box that
+	// represents the whole circuit, which points to nothing
+	next := map[Point3D]Point3D{}
+	circuitOf := func(p Point3D) Point3D {
+		for {
+			n, ok := next[p]
+			if !ok {
+				return p
+			}
+			p = n
+		}
+	}
+
+	var tree []PointPair
+	for pair := range day8Distances(points).Drain() {
+		if len(tree) == len(points)-1 {
+			break
+		}
+		a, b := circuitOf(pair.a), circuitOf(pair.b)
+		if a == b {
+			continue
+		}
+		next[a] = b
+		tree = append(tree, pair)
+	}
+	return tree
+}
+
+// Day8SVG writes to w a drawing of the junction boxes, seen from a corner,
+// and the cables that connect all of them with the least cable
+func Day8SVG(filename string, w io.Writer) error {
+	points := readDay8Points(filename)
+
+	project := func(p Point3D) render.Point {
+		r, _ := render.Isometric.Project(p.Vec3())
+		return r
+	}
+
+	svg := render.NewSVG()
+	cables := svg.Layer("cables", render.Style{Stroke: "orange"})
+	for _, pair := range day8SpanningTree(points) {
+		cables.Line(project(pair.a), project(pair.b))
+	}
+	boxes := svg.Layer("junction boxes", render.Style{Stroke: "black"})
+	for _, p := range points {
+		boxes.Point(project(p), "")
+	}
+
+	return svg.Write(w, 1000)
+}
+
+// day8Distances returns a heap with every pair of points, the closest pair at
+// the top
+func day8Distances(points []Point3D) *heap.Heap[PointPair] {
+	pairs := make([]PointPair, 0, len(points)*(len(points)-1)/2)
+	for i := 0; i < len(points)-1; i++ {
+		for j := i + 1; j < len(points); j++ {
+			pairs = append(pairs, NewPointPair(points[i], points[j]))
+		}
+	}
+	return heap.Heapify(pairs, PointPairLess)
+}
+
+// NewPoint3DFromLine parses a point like "162,817,812"
+func NewPoint3DFromLine(line []byte) (Point3D, error) {
 	parts := bytes.Split(line, []byte(","))
 	if len(parts) != 3 {
-		log.Fatalf("can't parse line as a 3d point; expected \"X,Y,Z\", but got: %q", line)
+		return Point3D{}, fmt.Errorf("can't parse line as a 3d point; expected \"X,Y,Z\", but got: %q", line)
 	}
 
 	x, err := strconv.Atoi(string(parts[0]))
 	if err != nil {
-		log.Fatalf("can't parse %q as X coordinate: %v", parts[0], err)
+		return Point3D{}, fmt.Errorf("can't parse %q as X coordinate: %v", parts[0], err)
 	}
 	y, err := strconv.Atoi(string(parts[1]))
 	if err != nil {
-		log.Fatalf("can't parse %q as Y coordinate: %v", parts[1], err)
+		return Point3D{}, fmt.Errorf("can't parse %q as Y coordinate: %v", parts[1], err)
 	}
 	z, err := strconv.Atoi(string(parts[2]))
 	if err != nil {
-		log.Fatalf("can't parse %q as Z coordinate: %v", parts[2], err)
+		return Point3D{}, fmt.Errorf("can't parse %q as Z coordinate: %v", parts[2], err)
 	}
 
-	return Point3D{X: x, Y: y, Z: z}
+	return Point3D{X: x, Y: y, Z: z}, nil
+}
+
+// Vec3 returns p as a point that can be projected to be drawn
+func (p Point3D) Vec3() render.Vec3 {
+	return render.Vec3{X: float64(p.X), Y: float64(p.Y), Z: float64(p.Z)}
 }
 
 func (p Point3D) String() string {
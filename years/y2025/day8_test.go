@@ -0,0 +1,190 @@
+package y2025
+
+import (
+	"bytes"
+	"cmp"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/unkiwii/aoc/lib/gen"
+)
+
+func TestDay8Part1(t *testing.T) {
+	filename := "input/day8.test"
+	want := 40
+	got := Day8Part1(10, filename)
+	if got != want {
+		t.Errorf("Day8Part1(%q) got %d; want: %d", filename, got, want)
+	}
+}
+
+func TestDay8Part2(t *testing.T) {
+	filename := "input/day8.test"
+	want := 25272
+	got := Day8Part2(filename)
+	if got != want {
+		t.Errorf("Day8Part2(%q) got %d; want: %d", filename, got, want)
+	}
+}
+
+func TestDay8SpanningTree(t *testing.T) {
+	filename := "input/day8.test"
+	points := readDay8Points(filename)
+	tree := day8SpanningTree(points)
+	if got, want := len(tree), len(points)-1; got != want {
+		t.Fatalf("day8SpanningTree(%q) got %d cables; want: %d", filename, got, want)
+	}
+
+	// the last cable is the one that makes a single circuit, as in Day8Part2
+	last := tree[len(tree)-1]
+	if got, want := last.a.X*last.b.X, 25272; got != want {
+		t.Errorf("day8SpanningTree(%q) got %d for the last cable; want: %d", filename, got, want)
+	}
+}
+
+func TestDay8SVG(t *testing.T) {
+	filename := "input/day8.test"
+	var buf bytes.Buffer
+	if err := Day8SVG(filename, &buf); err != nil {
+		t.Fatalf("Day8SVG(%q) failed: %v", filename, err)
+	}
+	if got, want := strings.Count(buf.String(), "<polyline"), 19; got != want {
+		t.Errorf("Day8SVG(%q) got %d cables; want: %d", filename, got, want)
+	}
+	if got, want := strings.Count(buf.String(), "<circle"), 20; got != want {
+		t.Errorf("Day8SVG(%q) got %d junction boxes; want: %d", filename, got, want)
+	}
+}
+
+// day8Input writes between 20 and 40 junction boxes, with every distance
+// between them different, so there is only one way to connect them
+func day8Input(r *rand.Rand, w io.Writer) {
+	var points []Point3D
+	for {
+		points = make([]Point3D, 20+r.IntN(21))
+		for i := range points {
+			points[i] = Point3D{X: r.IntN(1000), Y: r.IntN(1000), Z: r.IntN(1000)}
+		}
+		distances := map[int]bool{}
+		unique := true
+		for i := range points {
+			for j := i + 1; j < len(points); j++ {
+				d := points[i].SquaredLinearDistanceTo(points[j])
+				unique = unique && !distances[d]
+				distances[d] = true
+			}
+		}
+		if unique {
+			break
+		}
+	}
+	for _, p := range points {
+		fmt.Fprintf(w, "%d,%d,%d\n", p.X, p.Y, p.Z)
+	}
+}
+
+// day8Reference connects the closest pairs of boxes one at a time, it returns
+// the product of the sizes of the three largest circuits after the first
+// connections pairs, and the product of the X of the last pair that joins
+// every box in one circuit
+func day8Reference(input string, connections int) (largest, last int) {
+	var points [][3]int
+	for _, line := range strings.Fields(input) {
+		var p [3]int
+		for i, c := range strings.Split(line, ",") {
+			p[i], _ = strconv.Atoi(c)
+		}
+		points = append(points, p)
+	}
+
+	type pair struct{ a, b, distance int }
+	var pairs []pair
+	for a := range points {
+		for b := a + 1; b < len(points); b++ {
+			d := 0
+			for i := range 3 {
+				d += (points[a][i] - points[b][i]) * (points[a][i] - points[b][i])
+			}
+			pairs = append(pairs, pair{a, b, d})
+		}
+	}
+	slices.SortFunc(pairs, func(x, y pair) int { return cmp.Compare(x.distance, y.distance) })
+
+	// circuit[i] is the circuit of box i, joining two circuits renames one
+	circuit := make([]int, len(points))
+	for i := range circuit {
+		circuit[i] = i
+	}
+	circuits := len(points)
+
+	for i, p := range pairs {
+		if i == connections {
+			sizes := make([]int, len(points))
+			for _, c := range circuit {
+				sizes[c]++
+			}
+			slices.Sort(sizes)
+			slices.Reverse(sizes)
+			largest = sizes[0] * sizes[1] * sizes[2]
+		}
+
+		from, to := circuit[p.b], circuit[p.a]
+		if from == to {
+			continue
+		}
+		for j := range circuit {
+			if circuit[j] == from {
+				circuit[j] = to
+			}
+		}
+		circuits--
+		if circuits == 1 {
+			last = points[p.a][0] * points[p.b][0]
+			break
+		}
+	}
+	return largest, last
+}
+
+func TestDay8Part1AgainstReference(t *testing.T) {
+	gen.Check(t, 3000, day8Input,
+		func(filename string) int { return Day8Part1(10, filename) },
+		func(input string) int {
+			largest, _ := day8Reference(input, 10)
+			return largest
+		})
+}
+
+func TestDay8Part2AgainstReference(t *testing.T) {
+	gen.Check(t, 3000, day8Input, Day8Part2, func(input string) int {
+		_, last := day8Reference(input, 10)
+		return last
+	})
+}
+
+func FuzzNewPoint3DFromLine(f *testing.F) {
+	data, err := os.ReadFile("input/day8.test")
+	if err != nil {
+		f.Fatalf("can't read seeds: %v", err)
+	}
+	for _, line := range bytes.Split(data, []byte("\n")) {
+		f.Add(line)
+	}
+
+	f.Fuzz(func(t *testing.T, line []byte) {
+		p, err := NewPoint3DFromLine(line)
+		if err != nil {
+			return
+		}
+		again, err := NewPoint3DFromLine(fmt.Appendf(nil, "%d,%d,%d", p.X, p.Y, p.Z))
+		if err != nil || again != p {
+			t.Errorf("NewPoint3DFromLine(%q) got %v, that parses again as %v, %v", line, p, again, err)
+		}
+	})
+}
@@ -1,13 +1,14 @@
-package main
+package y2025
 
 import (
-	"bufio"
-	"bytes"
+	"context"
 	"fmt"
 	"io"
 	"log"
-	"os"
-	"strconv"
+
+	"github.com/unkiwii/aoc/lib/input"
+	"github.com/unkiwii/aoc/lib/render"
+	"github.com/unkiwii/aoc/lib/runner"
 )
 
 // --- Day 9: Movie Theater ---
@@ -104,8 +105,15 @@ import (
 // rectangle you can make?
 func Day9Part1(filename string) int {
 	grid := NewDay9GridFromFile(filename, false)
+	return day9LargestRect(grid).Area()
+}
+
+// day9LargestRect returns the largest rectangle with red tiles in two of its
+// opposite corners
+func day9LargestRect(grid Day9Grid) Rect {
 	l := len(grid.redTiles)
 
+	var largest Rect
 	var maxArea int
 	for i := 0; i < l-1; i++ {
 		for j := i + 1; j < l; j++ {
@@ -116,20 +124,58 @@ func Day9Part1(filename string) int {
 			area := r.Area()
 			if area > maxArea {
 				maxArea = area
+				largest = r
 			}
 		}
 	}
 
-	return maxArea
+	return largest
+}
+
+// Day9SVG writes to w a drawing of the loop of red tiles, with the largest
+// rectangle of Day9Part1 on top of it
+func Day9SVG(filename string, w io.Writer) error {
+	grid := NewDay9GridFromFile(filename, false)
+
+	loop := make([]render.Point, len(grid.redTiles))
+	for i, p := range grid.redTiles {
+		loop[i] = p.Render()
+	}
+
+	svg := render.NewSVG()
+	svg.Layer("loop", render.Style{Stroke: "green", Fill: "#d0f0d0"}).Polygon(loop)
+
+	r := day9LargestRect(grid)
+	rect := svg.Layer("largest", render.Style{Stroke: "blue", Fill: "blue", Opacity: 0.3, Width: 2})
+	rect.Rect(r.Top.Render(), r.Bottom.Render())
+	rect.Label(r.Top.Render(), fmt.Sprintf("area %d", r.Area()))
+
+	tiles := svg.Layer("red tiles", render.Style{Stroke: "red"})
+	for _, p := range loop {
+		tiles.Point(p, "")
+	}
+
+	return svg.Write(w, 1000)
 }
 
 func Day9Part2(filename string) int {
+	result, _ := Day9Part2Context(context.Background(), filename, nil)
+	return result
+}
+
+// Day9Part2Context is Day9Part2 but it stops as soon as ctx is done and
+// reports every pair of red tiles checked to progress
+func Day9Part2Context(ctx context.Context, filename string, progress *runner.Progress) (int, error) {
 	grid := NewDay9GridFromFile(filename, true)
 
 	var maxArea int
 
 	l := len(grid.redTiles)
+	progress.SetTotal(l * (l - 1) / 2)
 	for i := 0; i < l-1; i++ {
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
 	innerLoop:
 		for j := i + 1; j < l; j++ {
 			a := grid.redTiles[i]
@@ -177,15 +223,21 @@ func Day9Part2(filename string) int {
 				maxArea = area
 			}
 		}
+		progress.Add(l - 1 - i)
 	}
 
-	return maxArea
+	return maxArea, nil
 }
 
 type Point struct {
 	X, Y int
 }
 
+// Render returns where p is drawn
+func (p Point) Render() render.Point {
+	return render.Point{X: float64(p.X), Y: float64(p.Y)}
+}
+
 func (p Point) String() string {
 	return fmt.Sprintf("(%2d, %2d)", p.X, p.Y)
 }
@@ -239,9 +291,9 @@ const (
 )
 
 func NewDay9GridFromFile(filename string, withGreenTiles bool) Day9Grid {
-	file, err := os.Open(filename)
+	tiles, err := input.Scan[Point](filename, "%d,%d")
 	if err != nil {
-		log.Fatalf("can't open file %q: %v", filename, err)
+		log.Fatalf("can't read red tiles: %v", err)
 	}
 
 	var maxPoint Point
@@ -251,27 +303,7 @@ func NewDay9GridFromFile(filename string, withGreenTiles bool) Day9Grid {
 	maxX, maxY := 0, 0
 	minX, minY := MaxInt, MaxInt
 
-	r := bufio.NewReader(file)
-	for line, _, err := r.ReadLine(); err != io.EOF; line, _, err = r.ReadLine() {
-		if err != nil {
-			log.Fatalf("can't read bank: %v", err)
-		}
-
-		parts := bytes.Split(line, []byte(","))
-		if len(parts) != 2 {
-			log.Fatalf("can't parse line; expected X,Y but got: %s", line)
-		}
-
-		x, err := strconv.Atoi(string(parts[0]))
-		if err != nil {
-			log.Fatalf("can't parse X coordinate from line %q: %v", line, err)
-		}
-		y, err := strconv.Atoi(string(parts[1]))
-		if err != nil {
-			log.Fatalf("can't parse Y coordinate from line %q: %v", line, err)
-		}
-
-		point := Point{X: x, Y: y}
+	for _, point := range tiles {
 		if point.X > maxPoint.X && point.Y > maxPoint.Y {
 			maxPoint = point
 		}
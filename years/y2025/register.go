@@ -0,0 +1,39 @@
+package y2025
+
+import (
+	"context"
+
+	"github.com/unkiwii/aoc/lib/runner"
+)
+
+func init() {
+	runner.RegisterContext(2025, 1, 1, func(ctx context.Context, filename string, _ *runner.Progress) (int, error) {
+		return Day1Part1Context(ctx, 50, filename), nil
+	})
+	runner.RegisterContext(2025, 1, 2, func(ctx context.Context, filename string, _ *runner.Progress) (int, error) {
+		return Day1Part2Context(ctx, 50, filename), nil
+	})
+	runner.Register(2025, 2, 1, Day2Part1)
+	runner.Register(2025, 2, 2, Day2Part2)
+	runner.Register(2025, 3, 1, Day3Part1)
+	runner.Register(2025, 3, 2, Day3Part2)
+	runner.Register(2025, 4, 1, Day4Part1)
+	runner.RegisterContext(2025, 4, 2, Day4Part2Context)
+	runner.Register(2025, 5, 1, Day5Part1)
+	runner.Register(2025, 5, 2, Day5Part2)
+	runner.Register(2025, 6, 1, Day6Part1)
+	runner.Register(2025, 6, 2, Day6Part2)
+	runner.RegisterContext(2025, 7, 1, Day7Part1Context)
+	// runner.Register(2025, 7, 2, Day7Part2)
+	runner.Register(2025, 8, 1, func(filename string) int { return Day8Part1(1000, filename) })
+	runner.Register(2025, 8, 2, Day8Part2)
+	runner.Register(2025, 9, 1, Day9Part1)
+	// runner.RegisterContext(2025, 9, 2, Day9Part2Context)
+	runner.RegisterContext(2025, 10, 1, Day10Part1Context)
+	// runner.Register(2025, 10, 2, Day10Part2)
+
+	runner.RegisterRenderer(2025, 4, 2, ".gif", Day4Part2GIF)
+	runner.RegisterRenderer(2025, 7, 1, ".gif", Day7Part1GIF)
+	runner.RegisterRenderer(2025, 8, 2, ".svg", Day8SVG)
+	runner.RegisterRenderer(2025, 9, 1, ".svg", Day9SVG)
+}